@@ -0,0 +1,41 @@
+package identifiers
+
+import "fmt"
+
+// ABA validates the ABA routing number is a real routing number and returns it
+// An ABA routing number is a 9-digit code that identifies a US bank; the last digit is a check digit. The digits can be grouped with spaces or dashes, but anything else is an invalid character.
+func ABA(aba string) (string, error) {
+	clean, err := validateABA(aba)
+	record(TypeABA, err)
+	return clean, err
+}
+
+// validateABA validates the ABA routing number without recording metrics
+func validateABA(aba string) (string, error) {
+	digits := Normalize(aba)
+	if digits == "" {
+		err := emptyError(TypeABA)
+		return "", err
+	}
+	for i, char := range digits {
+		if char < '0' || char > '9' {
+			err := invalidCharacter(TypeABA, digits, i, "ABA routing number must only contain digits, found '%c'", char)
+			return "", err
+		}
+	}
+	if len(digits) != 9 {
+		err := fmt.Errorf("%w: ABA routing number must be 9 digits long. Provided: %s", ErrInvalidLength, provided(aba))
+		return "", err
+	}
+
+	var sum int
+	for i, weight := range [3]int{3, 7, 1} {
+		sum += weight * int(digits[i]-'0'+digits[i+3]-'0'+digits[i+6]-'0')
+	}
+	if sum%10 != 0 {
+		err := fmt.Errorf("%w: ABA routing number failed the 3-7-1 weighted checksum verification. Provided: %s", ErrBadChecksum, provided(digits))
+		return "", err
+	}
+
+	return digits, nil
+}
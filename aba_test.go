@@ -0,0 +1,28 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestABA(t *testing.T) {
+	tests := []struct {
+		aba  string
+		want string
+		err  error
+	}{
+		{"021000021", "021000021", nil}, //JPMorgan Chase
+		{"0210-0002-1", "021000021", nil},
+		{"ABA: 021 000 021", "021000021", nil},
+		{"0210000X21", "", ErrInvalidCharacter},
+		{"021000021.", "", ErrInvalidCharacter},
+		{"02100002", "", ErrInvalidLength},
+		{"021000022", "", ErrBadChecksum},
+	}
+	for _, test := range tests {
+		got, err := ABA(test.aba)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("ABA(%q) = %q, %v; want %q, %v", test.aba, got, err, test.want, test.err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package identifiers
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ISINs validates each of the ISINs and returns the cleaned ISINs and any errors, both index-aligned with the input
+func ISINs(in []string) (valid []string, errs []error) {
+	return Map(in, ISIN)
+}
+
+// CUSIPs validates each of the CUSIPs and returns the cleaned CUSIPs and any errors, both index-aligned with the input
+func CUSIPs(in []string) (valid []string, errs []error) {
+	return Map(in, CUSIP)
+}
+
+// FIGIs validates each of the FIGIs and returns the cleaned FIGIs and any errors, both index-aligned with the input
+func FIGIs(in []string) (valid []string, errs []error) {
+	return Map(in, FIGI)
+}
+
+// CUSIPsToISINs converts each of the CUSIPs to an ISIN with the country code, defaulting to US if empty, and returns the ISINs and any errors, both index-aligned with the input
+// An invalid CUSIP has an empty string in isins and its error in errs at the same index, and the rest are still converted.
+func CUSIPsToISINs(cusips []string, country string) (isins []string, errs []error) {
+	return Map(cusips, func(cusip string) (string, error) {
+		return CUSIPToISIN(cusip, country)
+	})
+}
+
+// SplitAndValidate splits a field holding several identifiers of the type on any of the separator characters, like "US0378331005;US5949181045", and validates each
+// Empty segments are skipped. The cleaned identifiers and any errors are index-aligned with the remaining segments.
+func SplitAndValidate(s, seps string, kind Type) (valid []string, errs []error) {
+	segments := strings.FieldsFunc(s, func(r rune) bool {
+		return strings.ContainsRune(seps, r)
+	})
+	for _, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			continue
+		}
+		clean, err := Validate(kind, segment) //the validators trim the segment themselves
+		valid = append(valid, clean)
+		errs = append(errs, err)
+	}
+	return
+}
+
+// Map runs the validator over each of the inputs and returns the cleaned inputs and any errors, both index-aligned with the input
+// An input that fails validation has an empty string in valid and its error in errs at the same index. Any of the validators or a custom one can be used.
+func Map[V ~func(string) (string, error)](in []string, validator V) (valid []string, errs []error) {
+	valid = make([]string, len(in))
+	errs = make([]error, len(in))
+	for i, id := range in {
+		valid[i], errs[i] = validator(id)
+	}
+	return
+}
+
+// validateAllChunk is the number of inputs handed to a worker at a time, so large inputs aren't sent over the channel one by one
+const validateAllChunk = 1024
+
+// ValidateAll validates each of the identifiers of the type across the number of worker goroutines and returns the results index-aligned with the input
+// Fewer than 1 worker uses one per CPU. If the context is cancelled the inputs not yet handed to a worker are left with empty results and the context's error is returned.
+func ValidateAll(ctx context.Context, in []string, kind Type, workers int) ([]Result, error) {
+	validator, ok := typeValidators[kind]
+	if !ok {
+		err := fmt.Errorf("Unable to validate identifiers of type %s", kind)
+		return nil, err
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	results := make([]Result, len(in))
+	chunks := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range chunks {
+				end := start + validateAllChunk
+				if end > len(in) {
+					end = len(in)
+				}
+				for i := start; i < end; i++ {
+					clean, err := validator(in[i])
+					record(kind, err)
+					results[i] = newResult(in[i], kind, clean, err)
+				}
+			}
+		}()
+	}
+
+	var err error
+	for start := 0; start < len(in) && err == nil; start += validateAllChunk {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case chunks <- start:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	close(chunks)
+	wg.Wait()
+
+	return results, err
+}
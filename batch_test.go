@@ -0,0 +1,61 @@
+package identifiers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestValidateAll(t *testing.T) {
+	in := make([]string, 3*validateAllChunk+5) //more than one chunk per worker, with a partial chunk at the end
+	want := make([]string, len(in))
+	for i := range in {
+		isin, err := GenerateISIN("US", strconv.Itoa(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		in[i] = isin
+		if i%3 == 0 { //every third fails its check digit
+			in[i] = isin[:11] + strconv.Itoa((int(isin[11]-'0')+1)%10)
+			continue
+		}
+		want[i] = isin
+	}
+
+	results, err := ValidateAll(context.Background(), in, TypeISIN, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != len(in) {
+		t.Fatalf("ValidateAll returned %d results; want %d", len(results), len(in))
+	}
+	for i, result := range results {
+		if result.Input != in[i] || result.Clean != want[i] || result.Valid != (want[i] != "") || result.Type != TypeISIN {
+			t.Errorf("ValidateAll result %d = %+v; want %q cleaned to %q", i, result, in[i], want[i])
+		}
+	}
+}
+
+func TestValidateAllCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	in := []string{"US0378331005", "US5949181045"}
+	results, err := ValidateAll(ctx, in, TypeISIN, 2)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ValidateAll with a cancelled context = %v; want %v", err, context.Canceled)
+	}
+	if len(results) != len(in) {
+		t.Fatalf("ValidateAll returned %d results; want %d", len(results), len(in))
+	}
+	for i, result := range results {
+		if result != (Result{}) { //nothing was handed to a worker
+			t.Errorf("ValidateAll result %d = %+v; want it empty", i, result)
+		}
+	}
+
+	if _, err := ValidateAll(context.Background(), in, TypeRIC, 2); err == nil {
+		t.Errorf("ValidateAll of type %v = nil; want an error", TypeRIC)
+	}
+}
@@ -0,0 +1,40 @@
+package identifiers
+
+import "fmt"
+
+// BIC validates the BIC and returns it normalized
+// A BIC (ISO 9362), also known as a SWIFT code, is a 4-letter institution code, 2-letter country code, 2-character location code, and optional 3-character branch code.
+func BIC(bic string) (string, error) {
+	clean, err := validateBIC(bic)
+	record(TypeBIC, err)
+	return clean, err
+}
+
+// validateBIC validates the BIC without recording metrics
+func validateBIC(bic string) (string, error) {
+	bic = Normalize(bic)
+	if bic == "" {
+		err := emptyError(TypeBIC)
+		return "", err
+	}
+	if len(bic) != 8 && len(bic) != 11 {
+		err := fmt.Errorf("%w: BIC must be 8 or 11 characters long. Provided: %s", ErrInvalidLength, provided(bic))
+		return "", err
+	}
+
+	for i, char := range bic {
+		switch {
+		case i < 4 && (char < 'A' || char > 'Z'):
+			err := invalidCharacter(TypeBIC, bic, i, "BIC institution code must be 4 letters, found '%c'", char)
+			return "", err
+		case i >= 4 && i < 6 && (char < 'A' || char > 'Z'):
+			err := invalidCharacter(TypeBIC, bic, i, "BIC country code must be 2 letters, found '%c'", char)
+			return "", err
+		case i >= 6 && (char < '0' || char > '9') && (char < 'A' || char > 'Z'):
+			err := invalidCharacter(TypeBIC, bic, i, "BIC location and branch codes must only contain letters and digits, found '%c'", char)
+			return "", err
+		}
+	}
+
+	return bic, nil
+}
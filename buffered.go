@@ -0,0 +1,67 @@
+package identifiers
+
+// BufferedValidator validates identifiers reusing an internal buffer, so validating a valid identifier doesn't allocate
+// It is not safe for concurrent use; create one per goroutine. The zero value is ready to use.
+type BufferedValidator struct {
+	buf []byte
+}
+
+// ISIN is the package-level ISIN using the validator's buffer for normalization and digit expansion
+// An ISIN that is already normalized is returned without allocating. Invalid ISINs are handed to the package-level ISIN for its error.
+func (v *BufferedValidator) ISIN(isin string) (string, error) {
+	trimmed := trim(isin)
+	if isinDashes(trimmed) != nil {
+		return ISIN(isin)
+	}
+
+	v.buf = v.buf[:0]
+	for i := 0; i < len(trimmed) && len(v.buf) < 12; i++ {
+		char := trimmed[i]
+		switch {
+		case char == ' ' || char == '-':
+			continue
+		case char >= 'a' && char <= 'z':
+			char -= 'a' - 'A'
+		case char >= 0x80: //non-ASCII characters are never valid, and ISIN uppercases them properly for the error
+			return ISIN(isin)
+		}
+		v.buf = append(v.buf, char)
+	}
+	if len(v.buf) < 12 {
+		return ISIN(isin)
+	}
+	for i, char := range v.buf[:12] {
+		if (i < 2 && (char < 'A' || char > 'Z')) || (i == 11 && (char < '0' || char > '9')) { //the country code and check digit
+			return ISIN(isin)
+		}
+	}
+
+	for _, char := range v.buf[:12] { //the digits are expanded after the ISIN in the buffer
+		switch {
+		case char >= '0' && char <= '9':
+			v.buf = append(v.buf, char)
+		case char >= 'A' && char <= 'Z':
+			value := char - 'A' + 10
+			v.buf = append(v.buf, '0'+value/10, '0'+value%10)
+		default:
+			return ISIN(isin)
+		}
+	}
+
+	clean := v.buf[:12]
+	if string(clean[:3]) == "BBG" && ValidateBloombergIDs {
+		return ISIN(isin)
+	}
+	if string(clean[:3]) != "BBG" { //just accept Bloomberg ID style
+		digits := v.buf[12:]
+		if luhnCheckDigit(digits[:len(digits)-1]) != digits[len(digits)-1] {
+			return ISIN(isin)
+		}
+	}
+
+	record(TypeISIN, nil)
+	if len(trimmed) >= 12 && trimmed[:12] == string(clean) { //the comparison doesn't allocate
+		return trimmed[:12], nil
+	}
+	return string(clean), nil
+}
@@ -0,0 +1,18 @@
+package identifiers
+
+import "testing"
+
+func BenchmarkISIN(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ISIN("US0378331005")
+	}
+}
+
+func BenchmarkBufferedISIN(b *testing.B) {
+	b.ReportAllocs()
+	var v BufferedValidator
+	for i := 0; i < b.N; i++ {
+		v.ISIN("US0378331005")
+	}
+}
@@ -0,0 +1,76 @@
+package identifiers
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+)
+
+// CachingValidator is a Validator that caches the results of another validator in a bounded LRU, keyed on the input with its surrounding whitespace trimmed
+// It is safe for concurrent use.
+type CachingValidator struct {
+	validator Validator
+	size      int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List //most recently used at the front
+}
+
+// cacheEntry is a cached result of validating the trimmed input
+type cacheEntry struct {
+	key   string
+	clean string
+	err   error
+}
+
+// NewCachingValidator returns a CachingValidator that caches the results of the validator for up to size inputs, evicting the least recently used
+// A size less than 1 is treated as 1.
+func NewCachingValidator(v Validator, size int) *CachingValidator {
+	if size < 1 {
+		size = 1
+	}
+	return &CachingValidator{
+		validator: v,
+		size:      size,
+		entries:   make(map[string]*list.Element, size),
+		order:     list.New(),
+	}
+}
+
+// Name returns the name of the cached validator
+func (c *CachingValidator) Name() string {
+	return c.validator.Name()
+}
+
+// Validate returns the cached result of validating the identifier, validating and caching it if it hasn't been seen or has been evicted
+// The identifier is validated as provided, so the result is the same as the wrapped validator's.
+// Only the surrounding whitespace is trimmed for the key, as inputs that normalize the same can still validate differently, such as "037833100 0" and "0378331000".
+func (c *CachingValidator) Validate(id string) (string, error) {
+	key := strings.TrimSpace(id)
+
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		entry := element.Value.(*cacheEntry)
+		c.mu.Unlock()
+		return entry.clean, entry.err
+	}
+	c.mu.Unlock()
+
+	clean, err := c.validator.Validate(id) //validated outside the lock so validators can run concurrently
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok { //another goroutine cached it in the meantime
+		c.order.MoveToFront(element)
+		return clean, err
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, clean: clean, err: err})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+	return clean, err
+}
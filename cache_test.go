@@ -0,0 +1,57 @@
+package identifiers
+
+import "testing"
+
+func TestCachingValidator(t *testing.T) {
+	tests := []struct {
+		validator Validator
+		id        string
+	}{
+		{NewValidator("PermID", PermID), "https://permid.org/1-4295905573"}, //the dash is part of the URL
+		{NewValidator("CUSIP", CUSIP), "037833100 0"},
+		{NewValidator("CUSIP", CUSIP), "03783310 0"},
+		{NewValidator("ISIN", ISIN), "us0378331005"},
+		{NewValidator("ISIN", ISIN), "US0378331004"},
+	}
+	for _, test := range tests {
+		cache := NewCachingValidator(test.validator, 2)
+		want, wantErr := test.validator.Validate(test.id)
+		for i := 0; i < 2; i++ { //the second is cached
+			if got, err := cache.Validate(test.id); got != want || (err == nil) != (wantErr == nil) {
+				t.Errorf("CachingValidator.Validate(%q) = %q, %v; want %q, %v", test.id, got, err, want, wantErr)
+			}
+		}
+	}
+
+	collisions := []struct { //normalize the same, but validate differently
+		validator Validator
+		first     string
+		second    string
+	}{
+		{NewValidator("CUSIP", CUSIP), "037833100 0", "0378331000"},
+		{NewValidator("ISIN", ISIN), "US03-78331005", "US-037833100-5"},
+	}
+	for _, test := range collisions {
+		cache := NewCachingValidator(test.validator, 2)
+		cache.Validate(test.first)
+		want, wantErr := test.validator.Validate(test.second)
+		if got, err := cache.Validate(test.second); got != want || (err == nil) != (wantErr == nil) {
+			t.Errorf("CachingValidator.Validate(%q) after %q = %q, %v; want %q, %v", test.second, test.first, got, err, want, wantErr)
+		}
+	}
+}
+
+func BenchmarkCachingValidator(b *testing.B) {
+	isin := NewValidator("ISIN", ISIN)
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			isin.Validate("US0378331005")
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		cache := NewCachingValidator(isin, 1)
+		for i := 0; i < b.N; i++ {
+			cache.Validate("US0378331005")
+		}
+	})
+}
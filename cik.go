@@ -0,0 +1,50 @@
+package identifiers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CIK validates the SEC Central Index Key and returns it zero-padded to 10 digits as EDGAR does
+// The digits can be grouped with spaces or dashes and labeled, as in "CIK: 320193" or EDGAR's "CIK0000320193", but anything else is an invalid character.
+func CIK(cik string) (string, error) {
+	clean, err := validateCIK(cik)
+	record(TypeCIK, err)
+	return clean, err
+}
+
+// validateCIK validates the CIK without recording metrics
+func validateCIK(cik string) (string, error) {
+	digits := strings.TrimPrefix(Normalize(cik), "CIK") //EDGAR labels CIKs without a separator
+	if digits == "" {
+		err := emptyError(TypeCIK)
+		return "", err
+	}
+	for i, char := range digits {
+		if char < '0' || char > '9' {
+			err := invalidCharacter(TypeCIK, digits, i, "CIK must only contain digits, found '%c'", char)
+			return "", err
+		}
+	}
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		err := fmt.Errorf("%w: CIK cannot be zero. Provided: %s", ErrInvalidFormat, provided(cik))
+		return "", err
+	}
+	if len(digits) > 10 {
+		err := fmt.Errorf("%w: CIK cannot be more than 10 digits long. Provided: %s", ErrInvalidLength, provided(cik))
+		return "", err
+	}
+
+	return strings.Repeat("0", 10-len(digits)) + digits, nil
+}
+
+// CIKInt validates the SEC Central Index Key and returns its numeric value
+func CIKInt(cik string) (int64, error) {
+	cik, err := CIK(cik)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(cik, 10, 64)
+}
@@ -0,0 +1,31 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCIK(t *testing.T) {
+	tests := []struct {
+		cik  string
+		want string
+		err  error
+	}{
+		{"320193", "0000320193", nil},
+		{"0000320193", "0000320193", nil},
+		{" 0000-320193 ", "0000320193", nil},
+		{"CIK0000320193", "0000320193", nil},
+		{"cik: 320193", "0000320193", nil},
+		{"abc12x3", "", ErrInvalidCharacter},
+		{"320193.0", "", ErrInvalidCharacter},
+		{"0", "", ErrInvalidFormat},
+		{"12345678901", "", ErrInvalidLength},
+		{"\t", "", ErrEmpty},
+	}
+	for _, test := range tests {
+		got, err := CIK(test.cik)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("CIK(%q) = %q, %v; want %q, %v", test.cik, got, err, test.want, test.err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cinsCountryGroups are the countries or regions indicated by the first character of a CINS
+var cinsCountryGroups = map[byte]string{
+	'A': "Austria",
+	'B': "Belgium",
+	'C': "Canada",
+	'D': "Germany",
+	'E': "Spain",
+	'F': "France",
+	'G': "United Kingdom",
+	'H': "Switzerland",
+	'J': "Japan",
+	'K': "Denmark",
+	'L': "Luxembourg",
+	'M': "Mid-East",
+	'N': "Netherlands",
+	'P': "South America",
+	'Q': "Australia",
+	'R': "Norway",
+	'S': "South Africa",
+	'T': "Italy",
+	'U': "United States",
+	'V': "Africa - Other",
+	'W': "Sweden",
+	'X': "Europe - Other",
+	'Y': "Asia",
+}
+
+// CINS takes a string containing a CINS but possibly more than just the CINS, strips it, validates it is a real CINS, and returns just the CINS
+// A CINS (CUSIP International Numbering System) is a 9-character CUSIP whose first character is a letter indicating the issuer's country or region.
+func CINS(cins string) (string, error) {
+	clean, err := validateCINS(cins)
+	record(TypeCINS, err)
+	return clean, err
+}
+
+// validateCINS validates the CINS without recording metrics
+func validateCINS(cins string) (string, error) {
+	cins = strings.ReplaceAll(Normalize(cins), ".", "")
+	if cins == "" {
+		err := emptyError(TypeCINS)
+		return "", err
+	}
+	if len(cins) < 9 {
+		err := fmt.Errorf("%w: CINS must be at least 9 characters long. Provided: %s", ErrTooShort, provided(cins))
+		return "", err
+	}
+	cins = cins[0:9]
+
+	if _, ok := cinsCountryGroups[cins[0]]; !ok {
+		err := invalidCharacter(TypeCINS, cins, 0, "CINS must start with a country group letter, found '%c'", cins[0])
+		return "", err
+	}
+	for i, char := range cins {
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeCINS, cins, i, "CINS contains the invalid character '%c'", char)
+			return "", err
+		}
+	}
+
+	if !Modulus10DoubleAddDouble(cins) {
+		err := fmt.Errorf("%w: CINS failed the Modulus 10 Double Add Double verification. Provided: %s", ErrBadChecksum, provided(cins))
+		return "", err
+	}
+
+	return cins, nil
+}
+
+// CINSCountryGroup validates the CINS and returns the country or region indicated by its first character
+func CINSCountryGroup(cins string) (string, error) {
+	cins, err := CINS(cins)
+	if err != nil {
+		return "", err
+	}
+	return cinsCountryGroups[cins[0]], nil
+}
@@ -0,0 +1,66 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CUSIPToISIN validates the CUSIP and converts it to an ISIN by prefixing the country code and appending the ISIN check digit
+// The country code defaults to US if empty. An 8-character CUSIP has its check digit appended, but Bloomberg IDs and CUSIPs with a failed check digit return an error.
+func CUSIPToISIN(cusip, countryCode string) (string, error) {
+	cusip, err := CompleteCUSIP(cusip) //the NSIN needs the full 9-character CUSIP
+	if err != nil {
+		return "", err
+	}
+
+	return nsinToISIN(cusip, countryCode, "US")
+}
+
+// ISINToCUSIP validates the US or CA ISIN and returns the CUSIP embedded in it
+// ISINs from other countries don't embed a CUSIP, so they return an error.
+func ISINToCUSIP(isin string) (string, error) {
+	isin, err := ISIN(isin)
+	if err != nil {
+		return "", err
+	}
+	if isin[0:2] != "US" && isin[0:2] != "CA" {
+		err := fmt.Errorf("Only US and CA ISINs embed a CUSIP, this ISIN is from %s. Provided: %s", isin[0:2], provided(isin))
+		return "", err
+	}
+
+	return CUSIPStrict(isin[2:11])
+}
+
+// SEDOLToISIN validates the SEDOL and converts it to an ISIN by left-padding it with zeros to a 9-character NSIN, prefixing the country code, and appending the ISIN check digit
+// The country code defaults to GB if empty.
+func SEDOLToISIN(sedol, countryCode string) (string, error) {
+	sedol, err := SEDOL(sedol)
+	if err != nil {
+		return "", err
+	}
+
+	return nsinToISIN("00"+sedol, countryCode, "GB")
+}
+
+// nsinToISIN prefixes the 9-character NSIN with the country code (or the default if the country code is empty) and appends the ISIN check digit
+func nsinToISIN(nsin, countryCode, defaultCountry string) (string, error) {
+	if countryCode == "" {
+		countryCode = defaultCountry
+	}
+	countryCode = strings.ToUpper(countryCode)
+	if len(countryCode) != 2 {
+		err := fmt.Errorf("%w: Country code must be 2 letters. Provided: %s", ErrInvalidLength, provided(countryCode))
+		return "", err
+	}
+	if countryCode[0] < 'A' || countryCode[0] > 'Z' || countryCode[1] < 'A' || countryCode[1] > 'Z' {
+		err := fmt.Errorf("%w: Country code must be 2 letters. Provided: %s", ErrInvalidCharacter, provided(countryCode))
+		return "", err
+	}
+
+	checkdigit, err := ISINCheckDigit(countryCode + nsin)
+	if err != nil {
+		return "", err
+	}
+
+	return countryCode + nsin + string(checkdigit), nil
+}
@@ -0,0 +1,24 @@
+package identifiers
+
+import "testing"
+
+func TestCUSIPToISIN(t *testing.T) {
+	tests := []struct {
+		cusip, country string
+		want           string
+		ok             bool
+	}{
+		{"037833100", "", "US0378331005", true},
+		{"03783310", "", "US0378331005", true},
+		{"037833100", "us", "US0378331005", true},
+		{"037833100", "USA", "", false},
+		{"037833101", "", "", false},
+		{"BL1234567", "", "", false}, //Bloomberg IDs aren't CUSIPs, so there's no ISIN for them
+	}
+	for _, test := range tests {
+		got, err := CUSIPToISIN(test.cusip, test.country)
+		if got != test.want || (err == nil) != test.ok {
+			t.Errorf("CUSIPToISIN(%q, %q) = %q, %v; want %q", test.cusip, test.country, got, err, test.want)
+		}
+	}
+}
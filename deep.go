@@ -0,0 +1,113 @@
+package identifiers
+
+import "fmt"
+
+// NSINValidators are the validators ISINValidateDeep runs on the 9-character NSIN of the ISINs from each country, keyed by country code
+// US and CA ISINs embed a CUSIP, and GB and IE ISINs embed a SEDOL left-padded with zeros. Add to it, usually in an init function, to validate other national numbering schemes.
+var NSINValidators = map[string]func(string) (string, error){
+	"US": CUSIPStrict,
+	"CA": CUSIPStrict,
+	"GB": paddedSEDOL,
+	"IE": paddedSEDOL,
+}
+
+// ISINValidateDeep validates the ISIN and, for countries with a validator in NSINValidators, validates the embedded NSIN too
+// The NSINs of other countries are only validated as part of the ISIN.
+func ISINValidateDeep(isin string) error {
+	isin, err := ISINStrict(isin)
+	if err != nil {
+		return err
+	}
+
+	validator, ok := NSINValidators[isin[0:2]]
+	if !ok {
+		return nil
+	}
+	_, err = validator(isin[2:11])
+	if err != nil {
+		err := fmt.Errorf("ISIN %s does not embed a valid NSIN: %w", provided(isin), err)
+		return err
+	}
+
+	return nil
+}
+
+// VerifyUSISIN checks both the check digit of the CUSIP embedded in the US or CA ISIN and the ISIN's own check digit, reporting which of them failed
+func VerifyUSISIN(isin string) error {
+	supplied, expected, err := ISINCheckDigitOf(isin)
+	if err != nil {
+		return err
+	}
+	isin = Normalize(isin)[0:12]
+	if supplied < '0' || supplied > '9' { //ISIN rejects it before the check digits are compared
+		err := invalidCharacter(TypeISIN, isin, 11, "ISIN check digit must be a digit, found '%c'", supplied)
+		return err
+	}
+	if isin[0:2] != "US" && isin[0:2] != "CA" {
+		err := fmt.Errorf("Only US and CA ISINs embed a CUSIP, this ISIN is from %s. Provided: %s", isin[0:2], provided(isin))
+		return err
+	}
+
+	cusipPassed := Modulus10DoubleAddDouble(isin[2:11])
+	isinPassed := supplied == expected
+	switch {
+	case !cusipPassed && !isinPassed:
+		err := fmt.Errorf("%w: Both the embedded CUSIP check digit and the ISIN check digit failed. Provided: %s", ErrBadChecksum, provided(isin))
+		return err
+	case !cusipPassed:
+		err := fmt.Errorf("%w: Embedded CUSIP failed the Modulus 10 Double Add Double verification, but the ISIN check digit passed. Provided: %s", ErrBadChecksum, provided(isin))
+		return err
+	case !isinPassed:
+		err := fmt.Errorf("%w: ISIN failed the Luhn verification, but the embedded CUSIP check digit passed. Provided: %s", ErrBadChecksum, provided(isin))
+		return err
+	}
+
+	return nil
+}
+
+// paddedSEDOL validates the NSIN is a SEDOL left-padded with 2 zeros
+func paddedSEDOL(nsin string) (string, error) {
+	if len(nsin) != 9 || nsin[0:2] != "00" {
+		err := fmt.Errorf("%w: NSIN must be a SEDOL padded with 2 zeros. Provided: %s", ErrInvalidFormat, provided(nsin))
+		return "", err
+	}
+	return SEDOL(nsin[2:])
+}
+
+// nsinTypes are the national numbering schemes used for the NSINs of the countries that map cleanly to one
+var nsinTypes = map[string]Type{
+	"US": TypeCUSIP,
+	"CA": TypeCUSIP,
+	"GB": TypeSEDOL,
+	"IE": TypeSEDOL,
+	"DE": TypeWKN,
+	"CH": TypeValoren,
+	"LI": TypeValoren,
+}
+
+// ISINNSINType validates the ISIN and returns the type of identifier its NSIN likely is, based on the country and the structure of the NSIN
+// Countries that don't map cleanly to a national numbering scheme, and NSINs that don't have the scheme's structure, are TypeUnknown.
+func ISINNSINType(isin string) (Type, error) {
+	isin, err := ISINStrict(isin)
+	if err != nil {
+		return TypeUnknown, err
+	}
+	nsin := isin[2:11]
+
+	kind := nsinTypes[isin[0:2]]
+	switch kind {
+	case TypeSEDOL: //the 7-character SEDOL is padded with 2 zeros
+		if nsin[0:2] != "00" {
+			return TypeUnknown, nil
+		}
+	case TypeWKN: //the 6-character WKN is padded with 3 zeros
+		if nsin[0:3] != "000" {
+			return TypeUnknown, nil
+		}
+	case TypeValoren:
+		if digitsOnly(nsin) != nsin {
+			return TypeUnknown, nil
+		}
+	}
+	return kind, nil
+}
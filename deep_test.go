@@ -0,0 +1,29 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerifyUSISIN(t *testing.T) {
+	tests := []struct {
+		isin string
+		err  error
+	}{
+		{"US0378331005", nil},
+		{"US-037833100-5", nil},
+		{"US0378331004", ErrBadChecksum},              //the ISIN check digit failed
+		{"US0378331013", ErrBadChecksum},              //the embedded CUSIP check digit failed
+		{"U-S-0-3-7-8-3-3-1-0-0-5", ErrInvalidFormat}, //rejected by ISIN, so there are no check digits to compare
+		{"US037833100X", ErrInvalidCharacter},
+		{"", ErrEmpty},
+	}
+	for _, test := range tests {
+		if err := VerifyUSISIN(test.isin); !errors.Is(err, test.err) {
+			t.Errorf("VerifyUSISIN(%q) = %v; want %v", test.isin, err, test.err)
+		}
+	}
+	if err := VerifyUSISIN("GB0002634946"); err == nil {
+		t.Errorf("VerifyUSISIN(%q) = nil; want an error", "GB0002634946")
+	}
+}
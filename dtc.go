@@ -0,0 +1,40 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DTCParticipant validates the DTC participant number and returns it zero-padded to 4 digits
+// A DTC participant number identifies a member of the Depository Trust Company from 1 to 9999; it has no check digit so only the range is validated. The digits can be grouped with spaces or dashes, but anything else is an invalid character.
+func DTCParticipant(participant string) (string, error) {
+	clean, err := validateDTCParticipant(participant)
+	record(TypeDTC, err)
+	return clean, err
+}
+
+// validateDTCParticipant validates the DTC participant number without recording metrics
+func validateDTCParticipant(participant string) (string, error) {
+	digits := Normalize(participant)
+	if digits == "" {
+		err := emptyError(TypeDTC)
+		return "", err
+	}
+	for i, char := range digits {
+		if char < '0' || char > '9' {
+			err := invalidCharacter(TypeDTC, digits, i, "DTC participant number must only contain digits, found '%c'", char)
+			return "", err
+		}
+	}
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		err := fmt.Errorf("%w: DTC participant number cannot be zero. Provided: %s", ErrInvalidFormat, provided(participant))
+		return "", err
+	}
+	if len(digits) > 4 {
+		err := fmt.Errorf("%w: DTC participant number cannot be more than 4 digits long. Provided: %s", ErrInvalidLength, provided(participant))
+		return "", err
+	}
+
+	return strings.Repeat("0", 4-len(digits)) + digits, nil
+}
@@ -0,0 +1,29 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDTCParticipant(t *testing.T) {
+	tests := []struct {
+		participant string
+		want        string
+		err         error
+	}{
+		{"15", "0015", nil},
+		{"0015", "0015", nil},
+		{" 0-015 ", "0015", nil},
+		{"0A1B5", "", ErrInvalidCharacter}, //not participant 15
+		{"15.", "", ErrInvalidCharacter},
+		{"0000", "", ErrInvalidFormat},
+		{"10000", "", ErrInvalidLength},
+		{"\t", "", ErrEmpty},
+	}
+	for _, test := range tests {
+		got, err := DTCParticipant(test.participant)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("DTCParticipant(%q) = %q, %v; want %q, %v", test.participant, got, err, test.want, test.err)
+		}
+	}
+}
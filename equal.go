@@ -0,0 +1,26 @@
+package identifiers
+
+import "strings"
+
+// Equal reports whether the identifiers are the same once normalized, ignoring surrounding whitespace, case, and separators, including the dots CUSIPs are grouped with
+func Equal(a, b string) bool {
+	return strings.ReplaceAll(Normalize(a), ".", "") == strings.ReplaceAll(Normalize(b), ".", "")
+}
+
+// EqualAs validates both identifiers as the type and reports whether their cleaned forms are the same
+// Identifiers that fail validation, or of types that can't be validated, are never equal.
+func EqualAs(a, b string, kind Type) bool {
+	validator, ok := typeValidators[kind]
+	if !ok {
+		return false
+	}
+	a, err := validator(a)
+	if err != nil {
+		return false
+	}
+	b, err = validator(b)
+	if err != nil {
+		return false
+	}
+	return a == b
+}
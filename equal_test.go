@@ -0,0 +1,23 @@
+package identifiers
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"US0378331005", " us-0378331005 ", true},
+		{"037.833.100", "037833100", true},
+		{"037833-10-0", "037833100", true},
+		{"037833100", "037833101", false},
+	}
+	for _, test := range tests {
+		if got := Equal(test.a, test.b); got != test.want {
+			t.Errorf("Equal(%q, %q) = %v; want %v", test.a, test.b, got, test.want)
+		}
+	}
+	if !EqualAs("037.833.100", "037833100", TypeCUSIP) {
+		t.Errorf("EqualAs(%q, %q, %v) = false; want true", "037.833.100", "037833100", TypeCUSIP)
+	}
+}
@@ -0,0 +1,70 @@
+package identifiers
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors wrapped by the validators so callers can check the reason for a failure with errors.Is
+var (
+	ErrEmpty            = errors.New("empty")
+	ErrTooShort         = errors.New("too short")
+	ErrInvalidLength    = errors.New("invalid length")
+	ErrInvalidCharacter = errors.New("invalid character")
+	ErrInvalidFormat    = errors.New("invalid format")
+	ErrBadChecksum      = errors.New("bad checksum")
+	ErrUnknownCode      = errors.New("unknown code")
+)
+
+// RedactInput replaces the identifier provided with "[redacted]" in error messages when set, for when identifiers can't be logged
+// The Input of a ValidationError is still set, so check it before logging ValidationErrors field by field.
+var RedactInput = false
+
+// provided returns the identifier to include in an error message, or a placeholder if RedactInput is set
+func provided(id string) string {
+	if RedactInput {
+		return "[redacted]"
+	}
+	return id
+}
+
+// ValidationError is returned when a specific character of the identifier is to blame for it failing validation
+// It wraps a sentinel error, such as ErrInvalidCharacter, for errors.Is.
+type ValidationError struct {
+	Kind   Type   //type of identifier being validated
+	Input  string //identifier being validated
+	Pos    int    //index of the character to blame in Input, or -1 if not applicable
+	Reason string //why validation failed
+	Err    error  //sentinel error wrapped
+}
+
+func (e *ValidationError) Error() string {
+	reason := e.Reason
+	if e.Pos >= 0 {
+		reason += fmt.Sprintf(" at index %d", e.Pos)
+	}
+	if e.Err != nil {
+		reason = fmt.Sprintf("%v: %s", e.Err, reason)
+	}
+	return fmt.Sprintf("%s. Provided: %s", reason, provided(e.Input))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// invalidCharacter returns a ValidationError wrapping ErrInvalidCharacter for the character at pos
+func invalidCharacter(kind Type, input string, pos int, format string, args ...any) error {
+	return &ValidationError{
+		Kind:   kind,
+		Input:  input,
+		Pos:    pos,
+		Reason: fmt.Sprintf(format, args...),
+		Err:    ErrInvalidCharacter,
+	}
+}
+
+// emptyError returns an error wrapping ErrEmpty for the kind of identifier, for input that is empty once normalized
+func emptyError(kind Type) error {
+	return fmt.Errorf("%w: %s is empty", ErrEmpty, kind)
+}
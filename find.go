@@ -0,0 +1,106 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// FindISINs returns every valid ISIN in the text, in the order they first appear and without duplicates
+// Bloomberg IDs are not reported as ISINs.
+func FindISINs(text string) []string {
+	return find(text, 12, isinStrict)
+}
+
+// FindCUSIPs returns every valid 9-character CUSIP in the text, in the order they first appear and without duplicates
+func FindCUSIPs(text string) []string {
+	return find(text, 9, cusipStrict)
+}
+
+// FindFIGIs returns every valid FIGI in the text, in the order they first appear and without duplicates
+func FindFIGIs(text string) []string {
+	return find(text, 12, validateFIGI)
+}
+
+// ISINAt finds the first valid ISIN anywhere in the string and returns it along with the text following it
+// Bloomberg IDs are not reported as ISINs. An error is only returned if there is no valid ISIN in the string.
+func ISINAt(s string) (isin, rest string, err error) {
+	for i := 0; i+12 <= len(s); i++ {
+		window := s[i : i+12]
+		isin, err := isinStrict(window)
+		if err == nil && isin == strings.ToUpper(window) { //normalization can't have trimmed anything
+			return isin, s[i+12:], nil
+		}
+	}
+
+	err = fmt.Errorf("%w: No valid ISIN found. Provided: %s", ErrInvalidFormat, provided(s))
+	return "", "", err
+}
+
+// Match is an identifier found in text by FindAll
+type Match struct {
+	Type  Type
+	Value string
+}
+
+// findAllTypes are the types of identifiers FindAll looks for, ordered from the most specific format to the least
+var findAllTypes = []struct {
+	kind      Type
+	length    int
+	validator func(string) (string, error)
+}{
+	{TypeFIGI, 12, validateFIGI},
+	{TypeISIN, 12, isinStrict},
+	{TypeCUSIP, 9, cusipStrict},
+	{TypeSEDOL, 7, validateSEDOL},
+}
+
+// FindAll returns every valid ISIN, CUSIP, FIGI, and SEDOL in the text, in the order they first appear and without duplicates
+// A word that is valid as more than one type is reported once, as the most specific type, so a FIGI is never reported as an ISIN.
+func FindAll(text string) []Match {
+	var found []Match
+	seen := make(map[Match]bool)
+	for _, word := range words(text) {
+		for _, t := range findAllTypes {
+			if len(word) != t.length {
+				continue
+			}
+			id, err := t.validator(word)
+			if err != nil {
+				continue
+			}
+			match := Match{Type: t.kind, Value: id}
+			if !seen[match] {
+				seen[match] = true
+				found = append(found, match)
+			}
+			break
+		}
+	}
+	return found
+}
+
+// find splits the text into words on whitespace and punctuation and returns the unique words of the given length that pass the validator
+func find(text string, length int, validator func(string) (string, error)) []string {
+	var found []string
+	seen := make(map[string]bool)
+	for _, word := range words(text) {
+		if len(word) != length {
+			continue
+		}
+		id, err := validator(word)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		found = append(found, id)
+	}
+	return found
+}
+
+// words splits the text into words on whitespace and punctuation
+func words(text string) []string {
+	return strings.FieldsFunc(text, func(char rune) bool {
+		return !unicode.IsLetter(char) && !unicode.IsDigit(char)
+	})
+}
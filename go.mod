@@ -1,7 +1,3 @@
 module github.com/cmarkh/identifiers
 
 go 1.19
-
-require github.com/cmarkh/errs v0.0.0-20230112225939-ce573e9050c9
-
-require github.com/cmarkh/go-mail v0.0.0-20230112213834-f3224667edb2 // indirect
@@ -0,0 +1,57 @@
+package identifiers
+
+import "fmt"
+
+// EAN13 validates the 13-digit EAN-13 (GTIN-13) barcode number and returns it without hyphens or spaces
+func EAN13(ean string) (string, error) {
+	clean, err := gtin(TypeEAN13, "EAN-13", ean, 13)
+	record(TypeEAN13, err)
+	return clean, err
+}
+
+// GTIN14 validates the 14-digit GTIN-14 and returns it without hyphens or spaces
+func GTIN14(gtin14 string) (string, error) {
+	clean, err := gtin(TypeGTIN14, "GTIN-14", gtin14, 14)
+	record(TypeGTIN14, err)
+	return clean, err
+}
+
+// gtin validates the GS1 number is the length, only digits, and passes the mod 10 verification
+func gtin(kind Type, name, code string, length int) (string, error) {
+	code = Normalize(code)
+	if code == "" {
+		err := emptyError(kind)
+		return "", err
+	}
+	if len(code) != length {
+		err := fmt.Errorf("%w: %s must be %d digits long. Provided: %s", ErrInvalidLength, name, length, provided(code))
+		return "", err
+	}
+	for i, char := range code {
+		if char < '0' || char > '9' {
+			err := invalidCharacter(kind, code, i, "%s contains the invalid character '%c'", name, char)
+			return "", err
+		}
+	}
+
+	if !validGTIN(code) {
+		err := fmt.Errorf("%w: %s failed the mod 10 verification. Provided: %s", ErrBadChecksum, name, provided(code))
+		return "", err
+	}
+	return code, nil
+}
+
+// validGTIN checks the string of digits passes the GS1 mod 10 verification, where the digits are weighted 3 and 1 alternately leftwards from the one before the check digit
+func validGTIN(digits string) bool {
+	body := digits[:len(digits)-1]
+	weights := []int{3, 1}
+	if len(body)%2 == 0 {
+		weights = []int{1, 3}
+	}
+	return WeightedMod10(body, weights, digitValue) == int(digits[len(digits)-1]-'0')
+}
+
+// digitValue returns the value of a digit
+func digitValue(char rune) int {
+	return int(char - '0')
+}
@@ -0,0 +1,68 @@
+package identifiers
+
+import "fmt"
+
+// ibanLengths are the lengths of the IBANs of each country, from the SWIFT IBAN registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// IBAN validates the IBAN and returns it in its compact form, without spaces
+// An IBAN is a 2-letter country code, 2 check digits, and a country-specific account number, validated with ISO 7064 mod 97-10.
+func IBAN(iban string) (string, error) {
+	clean, err := validateIBAN(iban)
+	record(TypeIBAN, err)
+	return clean, err
+}
+
+// validateIBAN validates the IBAN without recording metrics
+func validateIBAN(iban string) (string, error) {
+	iban = Normalize(iban)
+	if iban == "" {
+		err := emptyError(TypeIBAN)
+		return "", err
+	}
+	if len(iban) < 4 {
+		err := fmt.Errorf("%w: IBAN must be at least 4 characters long. Provided: %s", ErrTooShort, provided(iban))
+		return "", err
+	}
+
+	for i, char := range iban {
+		switch {
+		case i < 2 && (char < 'A' || char > 'Z'):
+			err := invalidCharacter(TypeIBAN, iban, i, "IBAN country code must be 2 letters, found '%c'", char)
+			return "", err
+		case i >= 2 && i < 4 && (char < '0' || char > '9'):
+			err := invalidCharacter(TypeIBAN, iban, i, "IBAN check digits must be digits, found '%c'", char)
+			return "", err
+		case (char < '0' || char > '9') && (char < 'A' || char > 'Z'):
+			err := invalidCharacter(TypeIBAN, iban, i, "IBAN contains the invalid character '%c'", char)
+			return "", err
+		}
+	}
+
+	length, ok := ibanLengths[iban[0:2]]
+	if !ok {
+		err := fmt.Errorf("%w: IBAN country %s does not use IBANs. Provided: %s", ErrUnknownCode, iban[0:2], provided(iban))
+		return "", err
+	}
+	if len(iban) != length {
+		err := fmt.Errorf("%w: IBAN for %s must be %d characters long. Provided: %s", ErrInvalidLength, iban[0:2], length, provided(iban))
+		return "", err
+	}
+
+	if mod97(iban[4:]+iban[0:4]) != 1 { //the country code and check digits are moved to the end
+		err := fmt.Errorf("%w: IBAN failed the ISO 7064 mod 97-10 verification. Provided: %s", ErrBadChecksum, provided(iban))
+		return "", err
+	}
+
+	return iban, nil
+}
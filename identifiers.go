@@ -5,106 +5,651 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
-
-	"github.com/cmarkh/errs"
 )
 
+// Logger is called with warnings the validators don't return as errors, such as a CUSIP missing its check digit
+// It does nothing by default; set it to log the warnings.
+var Logger = func(err error) {}
+
+// AllowEightCharCUSIP controls whether CUSIP accepts a CUSIP missing its check digit, assuming it passes
+// It is true by default; set it to false to return an error for 8-character CUSIPs instead. CUSIPStrict never accepts them.
+var AllowEightCharCUSIP = true
+
+// ValidateBloombergIDs controls whether ISIN validates the Bloomberg IDs it accepts in place of an ISIN as BBGIDs
+// It is false by default, so anything starting with BBG is accepted; set it to true to catch corrupt Bloomberg IDs.
+var ValidateBloombergIDs = false
+
+// FIGIAllowTrailing controls whether FIGI strips any content beyond the 12 characters of the FIGI
+// It is true by default; set it to false to make FIGI return an error for longer input, as FIGIExact always does.
+var FIGIAllowTrailing = true
+
 //reference docs: https://www.cusip.com/pdf/CUSIP_Intro_03.14.11.pdf
 
 // FIGI takes a string containing an FIGI but possibly more than just the FIGI, strips it, validates it is a real FIGI, and returns just the FIGI
 // An FIGI is a 12-character code that identifies a financial security.
+// A failed check digit is a plain ErrBadChecksum; use FIGICheckDigitOf to find the check digit that was expected.
 func FIGI(figi string) (string, error) {
+	clean, err := validateFIGI(figi)
+	record(TypeFIGI, err)
+	return clean, err
+}
+
+// validateFIGI validates the FIGI without recording metrics
+func validateFIGI(figi string) (string, error) {
+	figi = Normalize(figi)
+	if figi == "" {
+		err := emptyError(TypeFIGI)
+		return "", err
+	}
 	if len(figi) < 12 {
-		err := fmt.Errorf("FIGI must be at least 12 characters long. Provided: %s", figi)
+		err := fmt.Errorf("%w: FIGI must be at least 12 characters long. Provided: %s", ErrTooShort, provided(figi))
+		return "", err
+	}
+	if len(figi) > 12 && !FIGIAllowTrailing {
+		err := fmt.Errorf("%w: FIGI must be exactly 12 characters long. Provided: %s", ErrInvalidLength, provided(figi))
 		return "", err
 	}
 	figi = figi[0:12]
 
-	ascii, err := ascii(figi[3:12])
+	err := figiStructure(figi)
 	if err != nil {
 		return "", err
 	}
 
-	if !ValidLuhn(ascii) {
-		err := fmt.Errorf("FIGI failed the Luhn verification. Provided: %s", figi)
+	if modulus10CheckDigit(figi[:11]) != figi[11] { //FIGI uses the same check digit algorithm as CUSIP over all 11 leading characters
+		err := fmt.Errorf("%w: FIGI failed the Modulus 10 Double Add Double verification. Provided: %s", ErrBadChecksum, provided(figi))
 		return "", err
 	}
 
 	return figi, nil
 }
 
+// FIGIExact is FIGI for strings that should contain exactly one FIGI, so any content beyond the 12 characters is an error rather than stripped
+// It behaves the same whether or not FIGIAllowTrailing is set.
+func FIGIExact(figi string) (string, error) {
+	clean, err := validateFIGIExact(figi)
+	record(TypeFIGI, err)
+	return clean, err
+}
+
+// validateFIGIExact validates the exact FIGI without recording metrics
+func validateFIGIExact(figi string) (string, error) {
+	figi = Normalize(figi)
+	if figi == "" {
+		err := emptyError(TypeFIGI)
+		return "", err
+	}
+	if len(figi) != 12 {
+		err := fmt.Errorf("%w: FIGI must be exactly 12 characters long. Provided: %s", ErrInvalidLength, provided(figi))
+		return "", err
+	}
+	return validateFIGI(figi)
+}
+
+// ValidFIGI reports whether the string starts with a valid FIGI
+func ValidFIGI(figi string) bool {
+	_, err := FIGI(figi)
+	return err == nil
+}
+
+// FIGICheckDigit computes the check digit for the first 11 characters of a FIGI
+func FIGICheckDigit(body string) (byte, error) {
+	if len(body) != 11 {
+		err := fmt.Errorf("%w: FIGI base must be 11 characters long. Provided: %s", ErrInvalidLength, provided(body))
+		return 0, err
+	}
+
+	err := figiStructure(body)
+	if err != nil {
+		return 0, err
+	}
+
+	return modulus10CheckDigit(body), nil
+}
+
+// FIGICheckDigitOf returns the check digit supplied in the FIGI along with the check digit expected for its first 11 characters
+// Only the structure of the FIGI is validated, so the check digits can be compared even when they don't match.
+func FIGICheckDigitOf(figi string) (supplied, expected byte, err error) {
+	figi = Normalize(figi)
+	if figi == "" {
+		err := emptyError(TypeFIGI)
+		return 0, 0, err
+	}
+	if len(figi) < 12 {
+		err := fmt.Errorf("%w: FIGI must be at least 12 characters long. Provided: %s", ErrTooShort, provided(figi))
+		return 0, 0, err
+	}
+
+	expected, err = FIGICheckDigit(figi[:11])
+	if err != nil {
+		return 0, 0, err
+	}
+	return figi[11], expected, nil
+}
+
+// GenerateFIGI appends the check digit to the first 11 characters of a FIGI and returns the full 12-character FIGI
+func GenerateFIGI(prefix string) (string, error) {
+	checkdigit, err := FIGICheckDigit(prefix)
+	if err != nil {
+		return "", err
+	}
+	return prefix + string(checkdigit), nil
+}
+
+// BBGID validates the Bloomberg Global ID and returns just the BBGID
+// A BBGID is a FIGI issued by Bloomberg, so it starts with BBG and has a FIGI check digit. It is reported to the Metrics as a FIGI.
+func BBGID(bbgid string) (string, error) {
+	clean, err := validateBBGID(bbgid)
+	record(TypeFIGI, err)
+	return clean, err
+}
+
+// validateBBGID validates the BBGID without recording metrics
+func validateBBGID(bbgid string) (string, error) {
+	bbgid, err := validateFIGI(bbgid)
+	if err != nil {
+		return "", err
+	}
+	if bbgid[0:3] != "BBG" {
+		err := fmt.Errorf("%w: BBGID must start with BBG. Provided: %s", ErrInvalidFormat, provided(bbgid))
+		return "", err
+	}
+	return bbgid, nil
+}
+
+// FIGIParts are the structural components of a FIGI
+// Whether a FIGI is for a share class, composite, or exchange-level instrument isn't encoded in it, so it can only be found with OpenFIGI.
+type FIGIParts struct {
+	Prefix     string //2-character prefix of the provider that issued the FIGI, BB for Bloomberg
+	Indicator  string //third character, always G for global
+	ID         string //8-character identifier assigned by the provider
+	CheckDigit string
+}
+
+// ParseFIGI validates the FIGI and splits it into its structural components
+func ParseFIGI(figi string) (FIGIParts, error) {
+	figi, err := FIGI(figi)
+	if err != nil {
+		return FIGIParts{}, err
+	}
+
+	parts := FIGIParts{
+		Prefix:     figi[0:2],
+		Indicator:  figi[2:3],
+		ID:         figi[3:11],
+		CheckDigit: figi[11:12],
+	}
+	return parts, nil
+}
+
+// figiStructure checks the characters of the FIGI, or of the first 11 characters of a FIGI, are allowed in their positions
+func figiStructure(figi string) error {
+	if strings.Contains(figiInvalidPrefixes, figi[0:2]+" ") {
+		err := fmt.Errorf("%w: FIGI cannot start with %s. Provided: %s", ErrInvalidFormat, figi[0:2], provided(figi))
+		return err
+	}
+	for i, char := range figi {
+		switch {
+		case i < 2 && !consonant(char):
+			err := invalidCharacter(TypeFIGI, figi, i, "FIGI must start with 2 consonants, found '%c'", char)
+			return err
+		case i == 2 && char != 'G':
+			err := invalidCharacter(TypeFIGI, figi, i, "FIGI third character must be G, found '%c'", char)
+			return err
+		case i > 2 && i < 11 && !consonant(char) && (char < '0' || char > '9'):
+			err := invalidCharacter(TypeFIGI, figi, i, "FIGI characters 4 to 11 must be consonants or digits, found '%c'", char)
+			return err
+		case i == 11 && (char < '0' || char > '9'):
+			err := invalidCharacter(TypeFIGI, figi, i, "FIGI check digit must be a digit, found '%c'", char)
+			return err
+		}
+	}
+	return nil
+}
+
+// figiInvalidPrefixes are the 2-letter FIGI prefixes that are not allowed, to avoid confusion with ISIN country codes
+const figiInvalidPrefixes = "BS BM GG GB GH KY VG "
+
 // ISIN takes a string containing an ISIN but possibly more than just the ISIN, strips it, validates it is a real ISIN, and returns just the ISIN
 // An ISIN is a 12-character code that identifies a financial security.
+// Spaces are removed first, along with the dashes of the grouped display form "US-037833100-5"; dashes anywhere else in the ISIN are an error.
+// Letters are uppercased, so mixed-case input like "uS0378331005" returns the canonical "US0378331005".
 func ISIN(isin string) (string, error) {
+	clean, err := validateISIN(isin, false)
+	record(TypeISIN, err)
+	return clean, err
+}
+
+// ISINStrict is ISIN without the Bloomberg ID shortcut, so only real ISINs with a valid check digit are accepted
+func ISINStrict(isin string) (string, error) {
+	clean, err := validateISIN(isin, true)
+	record(TypeISIN, err)
+	return clean, err
+}
+
+// ValidISIN reports whether the string starts with a valid ISIN
+func ValidISIN(isin string) bool {
+	_, err := ISIN(isin)
+	return err == nil
+}
+
+// validateISIN validates the ISIN, accepting Bloomberg IDs in place of an ISIN unless strict
+func validateISIN(isin string, strict bool) (string, error) {
+	trimmed := trim(isin)
+	isin = Normalize(isin)
+	if isin == "" {
+		err := emptyError(TypeISIN)
+		return "", err
+	}
+	err := isinDashes(trimmed)
+	if err != nil {
+		return "", err
+	}
 	if len(isin) < 12 {
-		err := fmt.Errorf("ISIN must be at least 12 characters long. Provided: %s", isin)
+		err := fmt.Errorf("%w: ISIN must be at least 12 characters long. Provided: %s", ErrTooShort, provided(isin))
 		return "", err
 	}
 	isin = isin[0:12]
 
-	if isin[:3] == "BBG" { //just accept Bloomberg ID style
-		return isin, nil
+	for i, char := range isin {
+		if i < 2 && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeISIN, isin, i, "ISIN country code must be 2 letters, found '%c'", char)
+			return "", err
+		}
+		if i == 11 && (char < '0' || char > '9') {
+			err := invalidCharacter(TypeISIN, isin, i, "ISIN check digit must be a digit, found '%c'", char)
+			return "", err
+		}
 	}
 
-	ascii, err := ascii(isin)
+	digits, err := expand(TypeISIN, isin)
 	if err != nil {
-		if strings.HasSuffix(fmt.Sprint(err), "value out of range") {
-			return isin, nil
+		return "", err
+	}
+
+	if !strict && isin[:3] == "BBG" { //accept Bloomberg IDs, validating them if asked to
+		if ValidateBloombergIDs {
+			return validateBBGID(isin)
+		}
+		return isin, nil
+	}
+
+	if !ValidLuhnString(digits) { //the expanded ISIN can be too long for an int, so check it digit by digit
+		err := fmt.Errorf("%w: ISIN failed the Luhn verification. Provided: %s", ErrBadChecksum, provided(isin))
+		return "", err
+	}
+
+	return isin, nil
+}
+
+// isinDashes checks the dashes in the trimmed ISIN are only the single dashes of its grouped display form, like "US-037833100-5"
+// Dashes after the 12 characters of the ISIN are in the trailing content stripped from it, so they are ignored.
+func isinDashes(isin string) error {
+	chars := 0    //letters and digits of the ISIN so far
+	dash := false //whether the previous character was a dash
+	for _, char := range isin {
+		if chars == 12 {
+			return nil
+		}
+		if char == '-' {
+			if dash || (chars != 2 && chars != 11) { //after the country code or the NSIN
+				err := fmt.Errorf("%w: ISIN can only be split by dashes into its country code, NSIN, and check digit, like US-037833100-5. Provided: %s", ErrInvalidFormat, provided(isin))
+				return err
+			}
+			dash = true
+			continue
 		}
+		dash = false
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			chars++
+		}
+	}
+	return nil
+}
+
+// ISINCheckDigit computes the check digit for the first 11 characters of an ISIN (2-letter country code + 9-character NSIN)
+func ISINCheckDigit(body string) (byte, error) {
+	if len(body) != 11 {
+		err := fmt.Errorf("%w: ISIN base must be 11 characters long. Provided: %s", ErrInvalidLength, provided(body))
+		return 0, err
+	}
+	for i, char := range body {
+		if i < 2 && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeISIN, body, i, "ISIN country code must be 2 letters, found '%c'", char)
+			return 0, err
+		}
+	}
+
+	digits, err := expand(TypeISIN, body)
+	if err != nil {
+		return 0, err
+	}
+	return luhnCheckDigit(digits), nil
+}
+
+// ISINCheckDigitOf returns the check digit supplied in the ISIN along with the check digit expected for its first 11 characters
+// Only the structure of the ISIN is validated, so the check digits can be compared even when they don't match.
+func ISINCheckDigitOf(isin string) (supplied, expected byte, err error) {
+	trimmed := trim(isin)
+	isin = Normalize(isin)
+	if isin == "" {
+		err := emptyError(TypeISIN)
+		return 0, 0, err
+	}
+	err = isinDashes(trimmed)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(isin) < 12 {
+		err := fmt.Errorf("%w: ISIN must be at least 12 characters long. Provided: %s", ErrTooShort, provided(isin))
+		return 0, 0, err
+	}
+
+	expected, err = ISINCheckDigit(isin[:11])
+	if err != nil {
+		return 0, 0, err
+	}
+	return isin[11], expected, nil
+}
+
+// ISINChecksumValid reports whether the check digit of the ISIN is valid, for callers that have already normalized it
+// The ISIN must be exactly 12 characters of digits and uppercase letters; it is not normalized, stripped, or checked for structure, and anything else is reported as invalid.
+func ISINChecksumValid(isin string) bool {
+	if len(isin) != 12 {
+		return false
+	}
+	digits, err := expand(TypeISIN, isin)
+	if err != nil {
+		return false
+	}
+	return ValidLuhnString(digits) //the expanded ISIN can be too long for an int, so check it digit by digit
+}
+
+// ISINConfidence scores how likely the string starts with a real ISIN, for ranking candidates in noisy data:
+//   - 1 if it is a valid ISIN
+//   - 0.5 if it has the structure of an ISIN with a known country code, but its check digit fails
+//   - 0.25 if it has the structure of an ISIN, but its country code is unknown and its check digit fails
+//   - 0 if it doesn't have the structure of an ISIN
+func ISINConfidence(s string) float64 {
+	supplied, expected, err := ISINCheckDigitOf(s)
+	if err != nil || supplied < '0' || supplied > '9' {
+		return 0
+	}
+	if supplied == expected {
+		return 1
+	}
+	if ISINCountries[Normalize(s)[0:2]] {
+		return 0.5
+	}
+	return 0.25
+}
 
+// GenerateISIN left-pads the NSIN with zeros to 9 characters, prefixes the 2-letter country code, and appends the check digit, returning the full 12-character ISIN
+func GenerateISIN(country, nsin string) (string, error) {
+	nsin = Normalize(nsin)
+	if nsin == "" {
+		err := emptyError(TypeISIN)
 		return "", err
 	}
+	if len(nsin) > 9 {
+		err := fmt.Errorf("%w: NSIN must be at most 9 characters long. Provided: %s", ErrInvalidLength, provided(nsin))
+		return "", err
+	}
+	for i, char := range nsin {
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeISIN, nsin, i, "NSIN must only contain letters and digits, found '%c'", char)
+			return "", err
+		}
+	}
 
-	if !ValidLuhn(ascii) {
-		err := fmt.Errorf("ISIN failed the Luhn verification. Provided: %s", isin)
+	return nsinToISIN(strings.Repeat("0", 9-len(nsin))+nsin, country, "")
+}
+
+// ISINCountry validates the ISIN and returns its 2-letter country code
+func ISINCountry(isin string) (string, error) {
+	isin, err := ISIN(isin)
+	if err != nil {
 		return "", err
 	}
+	return isin[0:2], nil
+}
 
-	return isin, nil
+// ISINNSIN validates the ISIN and returns its 9-character National Securities Identifying Number
+func ISINNSIN(isin string) (string, error) {
+	isin, err := ISIN(isin)
+	if err != nil {
+		return "", err
+	}
+	return isin[2:11], nil
 }
 
 // CUSIP takes a string containing an CUSIP but possibly more than just the CUSIP, strips it, validates it is a real CUSIP, and returns just the CUSIP
 // An CUSIP is a 9-character code that identifies a financial security.
+// Spaces, dashes, and dots are removed first, so grouped forms like "037833-10-0" and separated check digits like "03783310 0" are accepted.
+// A CUSIP written with a separated check digit must have exactly 8 characters before it.
+// Letters are uppercased, so the CUSIP returned is always in its canonical uppercase form.
 func CUSIP(cusip string) (string, error) {
+	clean, err := validateCUSIP(cusip, false)
+	record(TypeCUSIP, err)
+	return clean, err
+}
+
+// CUSIPStrict is CUSIP without the Bloomberg ID shortcut or the 8-character form, so only real CUSIPs with a valid check digit are accepted
+func CUSIPStrict(cusip string) (string, error) {
+	clean, err := validateCUSIP(cusip, true)
+	record(TypeCUSIP, err)
+	return clean, err
+}
+
+// ValidCUSIP reports whether the string starts with a valid CUSIP
+func ValidCUSIP(cusip string) bool {
+	_, err := CUSIP(cusip)
+	return err == nil
+}
+
+// validateCUSIP validates the CUSIP, accepting Bloomberg IDs and, if allowed, CUSIPs missing their check digit unless strict
+func validateCUSIP(cusip string, strict bool) (string, error) {
+	separated := separatedCheckDigit(cusip)
+	cusip = strings.ReplaceAll(Normalize(cusip), ".", "") //dots are also used to separate the parts of a CUSIP
+	if cusip == "" {
+		err := emptyError(TypeCUSIP)
+		return "", err
+	}
+	//a check digit written on its own must follow the whole base, but longer text like "037833100 Apple 5" can just happen to end in a separator and a digit
+	if separated && len(cusip) != 9 && len(cusip) <= 10 {
+		err := fmt.Errorf("%w: CUSIP with a separated check digit must have exactly 8 characters before it. Provided: %s", ErrInvalidLength, provided(cusip))
+		return "", err
+	}
 	if len(cusip) < 8 {
-		err := fmt.Errorf("CUSIP must be at least 8 characters long. Provided: %s", cusip)
+		err := fmt.Errorf("%w: CUSIP must be at least 8 characters long. Provided: %s", ErrTooShort, provided(cusip))
 		return "", err
 	}
 	if len(cusip) == 8 {
+		if strict || !AllowEightCharCUSIP {
+			err := fmt.Errorf("%w: CUSIP is missing its check digit. Provided: %s", ErrTooShort, provided(cusip))
+			return "", err
+		}
 		cusip = cusip[0:8]
 	} else {
 		cusip = cusip[0:9]
 	}
 
-	if cusip[:2] == "BL" { //just accept Bloomberg ID style
+	if !strict && cusip[:2] == "BL" { //just accept Bloomberg ID style
 		return cusip, nil
 	}
 
+	for i, char := range cusip {
+		if !cusipChar(char) {
+			err := invalidCharacter(TypeCUSIP, cusip, i, "CUSIP contains the invalid character '%c'", char)
+			return "", err
+		}
+	}
+
 	if !Modulus10DoubleAddDouble(cusip) {
-		err := fmt.Errorf("CUSIP failed the Modulus 10 Double Add Double verification. Provided: %s", cusip)
-		errs.Log(err)
+		err := fmt.Errorf("%w: CUSIP failed the Modulus 10 Double Add Double verification. Provided: %s", ErrBadChecksum, provided(cusip))
 		return "", err
 	}
 
 	return cusip, nil
 }
 
-// Ascii converts the letters in the string to their ascii numbers
-func ascii(str string) (ascii int, err error) {
-	var new string
-	for _, char := range str {
-		if !unicode.IsDigit(char) {
-			new += fmt.Sprint(int(char) - 55)
-			continue
+// cusipSeparators are the characters used to separate the parts of a CUSIP
+const cusipSeparators = " -."
+
+// separatedCheckDigit reports whether the CUSIP is written with its check digit after a separator, such as "03783310 0"
+// Only a single trailing digit is taken as a check digit, so other trailing content like "037833100 A" is stripped as usual.
+func separatedCheckDigit(cusip string) bool {
+	cusip = trim(cusip)
+	n := len(cusip)
+	return n >= 3 && strings.IndexByte(cusipSeparators, cusip[n-2]) >= 0 && cusip[n-1] >= '0' && cusip[n-1] <= '9'
+}
+
+// CUSIPParts are the components of a CUSIP
+type CUSIPParts struct {
+	Issuer     string //6-character issuer number
+	Issue      string //2-character issue number
+	CheckDigit string //empty if the CUSIP was provided without its check digit
+}
+
+// ParseCUSIP validates the CUSIP and splits it into its issuer number, issue number, and check digit
+func ParseCUSIP(cusip string) (CUSIPParts, error) {
+	cusip, err := CUSIP(cusip)
+	if err != nil {
+		return CUSIPParts{}, err
+	}
+
+	parts := CUSIPParts{
+		Issuer: cusip[0:6],
+		Issue:  cusip[6:8],
+	}
+	if len(cusip) == 9 {
+		parts.CheckDigit = cusip[8:9]
+	}
+	return parts, nil
+}
+
+// CUSIP6 takes a string containing a 6-character CUSIP issuer number but possibly more, strips it, validates its format, and returns just the issuer number
+// The check digit covers the full 9-character CUSIP, so only the characters can be validated.
+func CUSIP6(cusip6 string) (string, error) {
+	cusip6 = strings.ReplaceAll(Normalize(cusip6), ".", "")
+	if cusip6 == "" {
+		err := emptyError(TypeCUSIP)
+		return "", err
+	}
+	if len(cusip6) < 6 {
+		err := fmt.Errorf("%w: CUSIP issuer number must be at least 6 characters long. Provided: %s", ErrTooShort, provided(cusip6))
+		return "", err
+	}
+	cusip6 = cusip6[0:6]
+
+	for i, char := range cusip6 {
+		if !cusipChar(char) {
+			err := invalidCharacter(TypeCUSIP, cusip6, i, "CUSIP issuer number contains the invalid character '%c'", char)
+			return "", err
 		}
-		new += fmt.Sprintf("%c", char)
 	}
 
-	ascii, err = strconv.Atoi(new)
+	return cusip6, nil
+}
+
+// CUSIP6Of validates the CUSIP and returns its 6-character issuer number, for grouping CUSIPs by issuer
+func CUSIP6Of(cusip string) (string, error) {
+	cusip, err := CUSIP(cusip)
 	if err != nil {
-		return
+		return "", err
 	}
-	return
+	return cusip[0:6], nil
+}
+
+// The issue types returned by CUSIPIssueType
+const (
+	CUSIPEquity       = "equity"
+	CUSIPFixedIncome  = "fixed-income"
+	CUSIPUnknownIssue = "unknown"
+)
+
+// CUSIPIssueType validates the CUSIP and returns a best-effort classification of the issue from its issue number
+// This is a heuristic: by convention equity issue numbers are 2 digits and fixed income issue numbers contain a letter, but not every issuer follows it, and any 2-digit issue number, including 00 and the 9x numbers, is reported as CUSIPEquity.
+// Listed options are identified by their OSI symbol rather than a CUSIP, so options are never detected. Other issues, such as private placements and Bloomberg IDs, are CUSIPUnknownIssue.
+func CUSIPIssueType(cusip string) (string, error) {
+	cusip, err := CUSIP(cusip)
+	if err != nil {
+		return "", err
+	}
+	if cusip[:2] == "BL" && !Modulus10DoubleAddDouble(cusip) { //Bloomberg IDs don't have a real issue number
+		return CUSIPUnknownIssue, nil
+	}
+
+	issue := cusip[6:8]
+	switch {
+	case strings.ContainsAny(cusip, cusipSpecialChars): //private placements can use them in the issuer number too
+		return CUSIPUnknownIssue, nil
+	case digitsOnly(issue) == issue:
+		return CUSIPEquity, nil
+	default:
+		return CUSIPFixedIncome, nil
+	}
+}
+
+// IsPPN reports whether the string starts with a valid CUSIP that is a Private Placement Number
+// PPNs are the CUSIPs that use the special characters '*', '@', and '#', which are reserved for private placements.
+func IsPPN(cusip string) bool {
+	cusip, err := CUSIP(cusip)
+	if err != nil {
+		return false
+	}
+	return strings.ContainsAny(cusip, cusipSpecialChars)
+}
+
+// expand replaces the letters in the string with their two digit values (A is 10, B is 11, etc.) and returns the resulting string of digits
+// The digits are appended to a single preallocated buffer rather than concatenated one character at a time.
+// Anything other than a digit or uppercase letter is an invalid character of the kind of identifier being expanded.
+func expand(kind Type, str string) (string, error) {
+	digits := make([]byte, 0, 2*len(str))
+	for i, char := range str {
+		switch {
+		case char >= '0' && char <= '9':
+			digits = append(digits, byte(char))
+		case char >= 'A' && char <= 'Z':
+			digits = strconv.AppendInt(digits, int64(char-'A'+10), 10)
+		default:
+			err := invalidCharacter(kind, str, i, "%s contains the invalid character '%c'", kind, char)
+			return "", err
+		}
+	}
+	return string(digits), nil
+}
+
+// ValidLuhnString checks a string of digits of any length is valid based on the Luhn algorithm, where the rightmost digit is the check digit
+func ValidLuhnString(digits string) bool {
+	if digits == "" {
+		return false
+	}
+	for _, char := range digits {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return luhnCheckDigit(digits[:len(digits)-1]) == digits[len(digits)-1]
+}
+
+// luhnCheckDigit computes the Luhn check digit to be appended to the string of digits
+// The digits are summed one by one so there is no limit on their length.
+func luhnCheckDigit[D string | []byte](digits D) byte {
+	var sum int
+	for i := len(digits) - 1; i >= 0; i-- {
+		cur := int(digits[i] - '0')
+
+		if (len(digits)-1-i)%2 == 0 { //double every other digit starting with the rightmost
+			cur = cur * 2
+			if cur > 9 {
+				cur = cur%10 + cur/10
+			}
+		}
+
+		sum += cur
+	}
+	return byte((10-sum%10)%10) + '0'
 }
 
 // ValidLuhn check number is valid or not based on Luhn algorithm
@@ -131,33 +676,153 @@ func ValidLuhn(number int) bool {
 	return (number%10+checksum(number/10))%10 == 0
 }
 
+// WeightedMod10 computes the check digit to be appended to the body for weighted modulus 10 schemes such as SEDOL and EAN-13
+// Each character's value, from valueOf, is multiplied by its weight, with the weights repeating if the body is longer than them, and the check digit is what brings the sum up to a multiple of 10.
+// The weights must not be empty.
+func WeightedMod10(body string, weights []int, valueOf func(rune) int) int {
+	var sum int
+	i := 0
+	for _, char := range body {
+		sum += weights[i%len(weights)] * valueOf(char)
+		i++
+	}
+	return (10 - sum%10) % 10
+}
+
+// Mod11Check computes the ISO 7064 mod 11-2 check character to be appended to the string of digits, which is a digit or 'X' for 10
+// It returns 0 if the body is empty or contains anything other than digits.
+func Mod11Check(body string) byte {
+	if body == "" {
+		return 0
+	}
+	var sum int
+	for _, char := range body {
+		if char < '0' || char > '9' {
+			return 0
+		}
+		sum = (sum + int(char-'0')) * 2 % 11
+	}
+
+	check := (12 - sum) % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte(check) + '0'
+}
+
+// ValidMod11 checks a string of digits is valid based on ISO 7064 mod 11-2, where the rightmost character is the check character
+func ValidMod11(s string) bool {
+	if len(s) < 2 {
+		return false
+	}
+	check := Mod11Check(s[:len(s)-1])
+	return check != 0 && check == s[len(s)-1]
+}
+
 // Modulus10DoubleAddDouble is the check digit algorithm for CUSIP verification
 func Modulus10DoubleAddDouble(cusip string) bool {
 	if len(cusip) != 9 {
-		errs.Log(fmt.Errorf("CUSIP missing check digit. Assuming Passed. Provided: %s", cusip))
+		Logger(fmt.Errorf("CUSIP missing check digit. Assuming Passed. Provided: %s", provided(cusip)))
 		return true
 	}
+	for _, char := range cusip[:8] {
+		if !cusipChar(char) { //other characters don't have a value
+			return false
+		}
+	}
 	checkdigit := cusip[8] - '0'
 
+	sum := modulus10Sum(cusip[:8]) //last digit is the check digit so skip it
+
+	return int64(checkdigit) == (10-sum%10)%10 //the check num = 10 - the last digit of the sum, or 0 if the last digit is 0
+}
+
+// CUSIPCheckDigit computes the check digit for the 8-character CUSIP base (6-character issuer + 2-character issue)
+func CUSIPCheckDigit(body string) (byte, error) {
+	if len(body) != 8 {
+		err := fmt.Errorf("%w: CUSIP base must be 8 characters long. Provided: %s", ErrInvalidLength, provided(body))
+		return 0, err
+	}
+	for i, char := range body {
+		if !cusipChar(char) {
+			err := invalidCharacter(TypeCUSIP, body, i, "CUSIP base contains the invalid character '%c'", char)
+			return 0, err
+		}
+	}
+
+	return modulus10CheckDigit(body), nil
+}
+
+// CompleteCUSIP takes an 8-character CUSIP base and appends its check digit, returning the full 9-character CUSIP
+// A full CUSIP is validated and returned unchanged, so its check digit must be correct.
+func CompleteCUSIP(cusip string) (string, error) {
+	base := strings.ReplaceAll(Normalize(cusip), ".", "")
+	if len(base) != 8 {
+		return CUSIPStrict(cusip)
+	}
+
+	checkdigit, err := CUSIPCheckDigit(base)
+	if err != nil {
+		return "", err
+	}
+	return base + string(checkdigit), nil
+}
+
+// cusipSpecialChars are the characters other than letters and digits allowed in a CUSIP, used for private placements
+const cusipSpecialChars = "*@#"
+
+// cusipChar reports whether the character is allowed in a CUSIP
+func cusipChar(char rune) bool {
+	return (char >= '0' && char <= '9') || (char >= 'A' && char <= 'Z') || strings.ContainsRune(cusipSpecialChars, char)
+}
+
+// mod97 returns the alphanumeric string modulo 97, where the letter A is 10, B is 11, etc.
+// The remainder is computed incrementally so strings of any length can be used without overflowing.
+func mod97(str string) int {
+	var rem int
+	for _, char := range str {
+		if char >= '0' && char <= '9' {
+			rem = (rem*10 + int(char-'0')) % 97
+			continue
+		}
+		rem = (rem*100 + int(char-'A') + 10) % 97 //letters expand to two digits
+	}
+	return rem
+}
+
+// consonant reports whether the character is an uppercase consonant
+func consonant(char rune) bool {
+	return char >= 'A' && char <= 'Z' && !strings.ContainsRune("AEIOU", char)
+}
+
+// modulus10CheckDigit computes the Modulus 10 Double Add Double check digit to be appended to the body
+func modulus10CheckDigit(body string) byte {
+	sum := modulus10Sum(body)
+	return byte((10-sum%10)%10) + '0'
+}
+
+// modulus10Sum is the Modulus 10 Double Add Double sum of the characters of a CUSIP base
+func modulus10Sum(body string) int64 {
 	var sum int64
-	for i, char := range cusip[:8] { //last digit is the check digit so skip it
+	for i, char := range body {
 		var intChar int64
 
-		if !unicode.IsDigit(char) {
-			intChar = int64(char - 'A' + 10) //The letter A will be 10; and the value of each subsequent letter will be the preceding letter’s value incremented by 1
-		} else {
+		switch {
+		case unicode.IsDigit(char):
 			intChar = int64(char - '0')
+		case strings.ContainsRune(cusipSpecialChars, char):
+			intChar = int64(strings.IndexRune(cusipSpecialChars, char) + 36) //* is 36, @ is 37, and # is 38
+		default:
+			intChar = int64(char - 'A' + 10) //The letter A will be 10; and the value of each subsequent letter will be the preceding letter’s value incremented by 1
 		}
 
 		if i%2 != 0 { //if char index in cusip is odd, double it
 			intChar *= 2
 		}
 
-		sum += intChar % 10
-		for intChar = int64(intChar / 10); intChar != 0; intChar = int64(intChar / 10) { //add the individual digits, not whole number
-			sum += intChar
+		for ; intChar != 0; intChar /= 10 { //add the individual digits, not whole number
+			sum += intChar % 10
 		}
 	}
-
-	return int64(checkdigit) == (10 - sum%10) //the check num = 10 - the last digit of the sum
+	return sum
 }
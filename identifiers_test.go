@@ -0,0 +1,370 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestISIN(t *testing.T) {
+	tests := []struct {
+		isin string
+		want string
+		err  error
+	}{
+		{"US0378331005", "US0378331005", nil},
+		{"US0378331004", "", ErrBadChecksum},
+		{"AU0000XVGZA3", "AU0000XVGZA3", nil},
+		{"GB00B03MLX29", "GB00B03MLX29", nil},
+		{"GB00B03MLX28", "", ErrBadChecksum},
+		//more than 19 digits once expanded, too long for an int64, so these used to skip the Luhn verification
+		{"XSZYXWVUTSR6", "XSZYXWVUTSR6", nil},
+		{"XSZYXWVUTSR5", "", ErrBadChecksum},
+		{"CHQRSTUVWXY2", "CHQRSTUVWXY2", nil},
+		{"CHQRSTUVWXY3", "", ErrBadChecksum},
+	}
+	for _, test := range tests {
+		got, err := ISIN(test.isin)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("ISIN(%q) = %q, %v; want %q, %v", test.isin, got, err, test.want, test.err)
+		}
+	}
+}
+
+func TestFIGI(t *testing.T) {
+	tests := []struct {
+		figi string
+		want string
+		err  error
+	}{
+		{"BBG000BLNNH6", "BBG000BLNNH6", nil}, //Apple
+		{"BBG000BPH459", "BBG000BPH459", nil}, //Microsoft
+		{"BBG000BLNNH5", "", ErrBadChecksum},
+		{"GBG000BLNNH6", "", ErrInvalidFormat}, //prefixes that are ISIN country codes aren't allowed
+		{"KYG000BLNNH6", "", ErrInvalidFormat},
+		{"ABG000BLNNH6", "", ErrInvalidCharacter}, //the first 2 characters must be consonants
+		{"BB1000BLNNH6", "", ErrInvalidCharacter}, //the third character must be G
+		{"BBG000BLANH6", "", ErrInvalidCharacter}, //characters 4 to 11 must be consonants or digits
+		{"BBG000BLNNHX", "", ErrInvalidCharacter},
+	}
+	for _, test := range tests {
+		got, err := FIGI(test.figi)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("FIGI(%q) = %q, %v; want %q, %v", test.figi, got, err, test.want, test.err)
+		}
+	}
+}
+
+func TestLowercase(t *testing.T) {
+	tests := []struct {
+		validator func(string) (string, error)
+		lower     string
+		upper     string
+	}{
+		{ISIN, "us0378331005", "US0378331005"},
+		{CUSIP, "38259p508", "38259P508"},
+		{FIGI, "bbg000blnnh6", "BBG000BLNNH6"},
+	}
+	for _, test := range tests {
+		lower, lowerErr := test.validator(test.lower)
+		upper, upperErr := test.validator(test.upper)
+		if lower != upper || lowerErr != nil || upperErr != nil {
+			t.Errorf("%q = %q, %v; %q = %q, %v; want the same", test.lower, lower, lowerErr, test.upper, upper, upperErr)
+		}
+	}
+}
+
+func TestCUSIPLetters(t *testing.T) {
+	tests := []struct {
+		cusip string
+		valid bool
+	}{
+		{"38259P508", true}, //Google
+		{"17275R102", true}, //Cisco
+		{"68389X105", true}, //Oracle
+		{"38259P509", false},
+		{"17275R103", false},
+		{"68389X104", false},
+	}
+	for _, test := range tests {
+		if got := Modulus10DoubleAddDouble(test.cusip); got != test.valid {
+			t.Errorf("Modulus10DoubleAddDouble(%q) = %v; want %v", test.cusip, got, test.valid)
+		}
+	}
+}
+
+func TestCUSIPPrivatePlacement(t *testing.T) {
+	tests := []struct {
+		cusip string
+		want  string
+		err   error
+	}{
+		{"12345*@#7", "12345*@#7", nil}, //* is 36, @ is 37, and # is 38
+		{"ABC#EF126", "ABC#EF126", nil},
+		{"12345*@#8", "", ErrBadChecksum},
+		{"12345*!#7", "", ErrInvalidCharacter},
+	}
+	for _, test := range tests {
+		got, err := CUSIP(test.cusip)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("CUSIP(%q) = %q, %v; want %q, %v", test.cusip, got, err, test.want, test.err)
+		}
+		if ppn := IsPPN(test.cusip); ppn != (test.err == nil) {
+			t.Errorf("IsPPN(%q) = %v; want %v", test.cusip, ppn, !ppn)
+		}
+	}
+}
+
+func FuzzISIN(f *testing.F) {
+	for _, seed := range []string{"", "U", "US", "US0378331005", "us0378331005", "BBG", "BBG000BLNNH6", "BBGÄÄÄÄÄÄÄÄÄ", "USÄ378331005", "日本0378331005", "US037833100X"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, validate := range []func(string) (string, error){ISIN, ISINStrict} {
+			isin, err := validate(s)
+			if err == nil && len(isin) != 12 {
+				t.Errorf("ISIN(%q) = %q; want 12 characters", s, isin)
+			}
+		}
+	})
+}
+
+func FuzzCUSIP(f *testing.F) {
+	for _, seed := range []string{"", "0", "BL", "037833100", "03783310", "03783310 0", "BL1234567", "BLÄÄÄÄÄÄÄ", "BL日本", "0378Ä3100", "12345*@#7"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, validate := range []func(string) (string, error){CUSIP, CUSIPStrict} {
+			cusip, err := validate(s)
+			if err == nil && len(cusip) != 8 && len(cusip) != 9 {
+				t.Errorf("CUSIP(%q) = %q; want 8 or 9 characters", s, cusip)
+			}
+		}
+	})
+}
+
+func TestExpand(t *testing.T) {
+	digits, err := expand(TypeISIN, "US0378331005")
+	if digits != "30280378331005" || err != nil {
+		t.Errorf("expand(%q) = %q, %v; want %q", "US0378331005", digits, err, "30280378331005")
+	}
+	for _, s := range []string{"us0378331005", "US03783310a5", "USÄ378331005", "US03783310É5"} { //only uppercase A to Z have a value
+		if digits, err := expand(TypeISIN, s); !errors.Is(err, ErrInvalidCharacter) {
+			t.Errorf("expand(%q) = %q, %v; want %v", s, digits, err, ErrInvalidCharacter)
+		}
+	}
+	if isin, err := ISIN("USÄ378331005"); !errors.Is(err, ErrInvalidCharacter) {
+		t.Errorf("ISIN(%q) = %q, %v; want %v", "USÄ378331005", isin, err, ErrInvalidCharacter)
+	}
+}
+
+func TestISINPositions(t *testing.T) {
+	tests := []struct {
+		isin string
+		pos  int
+	}{
+		{"US037833100X", 11}, //the check digit must be a digit
+		{"1S0378331005", 0},  //the country code must be letters
+		{"U10378331005", 1},
+	}
+	for _, test := range tests {
+		_, err := ISIN(test.isin)
+		var verr *ValidationError
+		if !errors.Is(err, ErrInvalidCharacter) || !errors.As(err, &verr) || verr.Pos != test.pos {
+			t.Errorf("ISIN(%q) = %v; want %v at index %d", test.isin, err, ErrInvalidCharacter, test.pos)
+		}
+	}
+}
+
+func TestMod11(t *testing.T) {
+	tests := []struct {
+		body string
+		want byte
+	}{
+		{"000000021825009", '7'}, //ORCID 0000-0002-1825-0097
+		{"000000021694233", 'X'}, //ORCID 0000-0002-1694-233X
+		{"", 0},
+		{"00000002169423A", 0},
+	}
+	for _, test := range tests {
+		if got := Mod11Check(test.body); got != test.want {
+			t.Errorf("Mod11Check(%q) = %q; want %q", test.body, got, test.want)
+		}
+	}
+
+	for s, want := range map[string]bool{"0000000218250097": true, "000000021694233X": true, "0000000218250098": false, "X": false} {
+		if got := ValidMod11(s); got != want {
+			t.Errorf("ValidMod11(%q) = %v; want %v", s, got, want)
+		}
+	}
+}
+
+func TestCUSIPZeroCheckDigit(t *testing.T) {
+	//the check digit is 0 when the sum is a multiple of 10, which used to be expected as 10 and fail every CUSIP ending in 0
+	for _, cusip := range []string{"037833100", "46625H100"} { //Apple, JPMorgan Chase
+		if got, err := CUSIPStrict(cusip); got != cusip || err != nil {
+			t.Errorf("CUSIPStrict(%q) = %q, %v; want %q", cusip, got, err, cusip)
+		}
+	}
+	if checkdigit, err := CUSIPCheckDigit("03783310"); checkdigit != '0' || err != nil {
+		t.Errorf("CUSIPCheckDigit(%q) = %q, %v; want '0'", "03783310", checkdigit, err)
+	}
+}
+
+func TestISINDashes(t *testing.T) {
+	tests := []struct {
+		isin string
+		want string
+		err  error
+	}{
+		{"US-037833100-5", "US0378331005", nil},
+		{"US 037833100 5", "US0378331005", nil},
+		{"ISIN: US-037833100-5", "US0378331005", nil},
+		{"US0378331005 - Apple Inc.", "US0378331005", nil}, //dashes in the trailing content are stripped with it
+		{"U-S-0-3-7-8-3-3-1-0-0-5", "", ErrInvalidFormat},
+		{"US-0378-3310-05", "", ErrInvalidFormat},
+		{"US--037833100-5", "", ErrInvalidFormat},
+		{"-US0378331005", "", ErrInvalidFormat},
+	}
+	for _, test := range tests {
+		got, err := ISIN(test.isin)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("ISIN(%q) = %q, %v; want %q, %v", test.isin, got, err, test.want, test.err)
+		}
+		var v BufferedValidator
+		if buffered, bufferedErr := v.ISIN(test.isin); buffered != got || (bufferedErr == nil) != (err == nil) {
+			t.Errorf("BufferedValidator.ISIN(%q) = %q, %v; want %q, %v", test.isin, buffered, bufferedErr, got, err)
+		}
+	}
+	if kind, _, err := Identify("U-S-0-3-7-8-3-3-1-0-0-5"); kind == TypeISIN {
+		t.Errorf("Identify(%q) = %v, %v; want it not to be an ISIN", "U-S-0-3-7-8-3-3-1-0-0-5", kind, err)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	for _, s := range []string{"", "   ", "\t\n"} {
+		for kind, validate := range typeValidators {
+			if got, err := validate(s); !errors.Is(err, ErrEmpty) {
+				t.Errorf("%v(%q) = %q, %v; want %v", kind, s, got, err, ErrEmpty)
+			}
+		}
+		if _, _, err := ISINCheckDigitOf(s); !errors.Is(err, ErrEmpty) {
+			t.Errorf("ISINCheckDigitOf(%q) = %v; want %v", s, err, ErrEmpty)
+		}
+		if _, _, err := FIGICheckDigitOf(s); !errors.Is(err, ErrEmpty) {
+			t.Errorf("FIGICheckDigitOf(%q) = %v; want %v", s, err, ErrEmpty)
+		}
+		if _, err := GenerateISIN("US", s); !errors.Is(err, ErrEmpty) {
+			t.Errorf("GenerateISIN(%q, %q) = %v; want %v", "US", s, err, ErrEmpty)
+		}
+		if _, _, err := Identify(s); !errors.Is(err, ErrEmpty) {
+			t.Errorf("Identify(%q) = %v; want %v", s, err, ErrEmpty)
+		}
+	}
+}
+
+func TestCUSIPSeparatedCheckDigit(t *testing.T) {
+	tests := []struct {
+		cusip string
+		want  string
+		err   error
+	}{
+		{"03783310 0", "037833100", nil},
+		{"03783310-0", "037833100", nil},
+		{"037833-10-0", "037833100", nil},
+		{"037833100", "037833100", nil},
+		{"037833100 A", "037833100", nil}, //only a trailing digit is a separated check digit
+		{"037833100 ;", "037833100", nil},
+		{"037833100 Apple 5", "037833100", nil}, //trailing content that ends in a separator and a digit
+		{"037833100 2024 5", "037833100", nil},
+		{"037833100 0", "", ErrInvalidLength},
+		{"0378331 0", "", ErrInvalidLength},
+	}
+	for _, test := range tests {
+		got, err := CUSIP(test.cusip)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("CUSIP(%q) = %q, %v; want %q, %v", test.cusip, got, err, test.want, test.err)
+		}
+	}
+}
+
+func TestMixedCase(t *testing.T) {
+	tests := []struct {
+		validator func(string) (string, error)
+		id        string
+		want      string
+	}{
+		{ISIN, "us0378331005", "US0378331005"}, //only the country code lowercase, from a buggy upstream system
+		{ISIN, "uS0378331005", "US0378331005"},
+		{ISIN, "Gb00b03mLX29", "GB00B03MLX29"},
+		{CUSIP, "38259p508", "38259P508"},
+		{CUSIP, "46625h100", "46625H100"},
+	}
+	for _, test := range tests {
+		got, err := test.validator(test.id)
+		if got != test.want || err != nil {
+			t.Errorf("%q = %q, %v; want %q", test.id, got, err, test.want)
+		}
+	}
+}
+
+func TestWeightedMod10(t *testing.T) {
+	alphanumeric := func(char rune) int {
+		if char >= '0' && char <= '9' {
+			return int(char - '0')
+		}
+		return int(char-'A') + 10
+	}
+	tests := []struct {
+		body    string
+		weights []int
+		want    int
+	}{
+		{"026349", []int{1, 3, 1, 7, 3, 9}, 4}, //SEDOL 0263494
+		{"B0YBKJ", []int{1, 3, 1, 7, 3, 9}, 7}, //SEDOL B0YBKJ7
+		{"400638133393", []int{1, 3}, 1},       //EAN-13 4006381333931, with the weights repeating
+		{"978030640615", []int{1, 3}, 7},       //ISBN-13 9780306406157
+	}
+	for _, test := range tests {
+		if got := WeightedMod10(test.body, test.weights, alphanumeric); got != test.want {
+			t.Errorf("WeightedMod10(%q, %v) = %d; want %d", test.body, test.weights, got, test.want)
+		}
+	}
+}
+
+func TestCUSIPIssueType(t *testing.T) {
+	tests := []struct {
+		cusip string
+		want  string
+	}{
+		{"037833100", CUSIPEquity},
+		{"037833AK6", CUSIPFixedIncome},
+		{"12345*@#7", CUSIPUnknownIssue}, //private placements
+		{"ABC#EF126", CUSIPUnknownIssue},
+		{"BL1234567", CUSIPUnknownIssue},
+	}
+	for _, test := range tests {
+		if got, err := CUSIPIssueType(test.cusip); got != test.want || err != nil {
+			t.Errorf("CUSIPIssueType(%q) = %q, %v; want %q", test.cusip, got, err, test.want)
+		}
+	}
+}
+
+func TestISINConfidence(t *testing.T) {
+	tests := []struct {
+		isin string
+		want float64
+	}{
+		{"US0378331005", 1},
+		{"US-037833100-5", 1},
+		{"US0378331004", 0.5},
+		{"QQ0378331004", 0.25},
+		{"U-S-0-3-7-8-3-3-1-0-0-5", 0}, //ISIN rejects dashes anywhere but the grouped display form
+		{"US03-78331005", 0},
+		{"US037833100", 0},
+	}
+	for _, test := range tests {
+		if got := ISINConfidence(test.isin); got != test.want {
+			t.Errorf("ISINConfidence(%q) = %v; want %v", test.isin, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package identifiers
+
+import "fmt"
+
+// Type is a kind of identifier
+type Type int
+
+const (
+	TypeUnknown Type = iota
+	TypeISIN
+	TypeCUSIP
+	TypeFIGI
+	TypeSEDOL
+	TypeLEI
+	TypeCINS
+	TypeWKN
+	TypeMIC
+	TypeBIC
+	TypeIBAN
+	TypeValoren
+	TypeABA
+	TypeISBN
+	TypeRIC
+	TypeRED
+	TypeOSI
+	TypePermID
+	TypeEAN13
+	TypeGTIN14
+	TypeCIK
+	TypeDTC
+)
+
+// String returns the name of the identifier type
+func (t Type) String() string {
+	switch t {
+	case TypeISIN:
+		return "ISIN"
+	case TypeCUSIP:
+		return "CUSIP"
+	case TypeFIGI:
+		return "FIGI"
+	case TypeSEDOL:
+		return "SEDOL"
+	case TypeLEI:
+		return "LEI"
+	case TypeCINS:
+		return "CINS"
+	case TypeWKN:
+		return "WKN"
+	case TypeMIC:
+		return "MIC"
+	case TypeBIC:
+		return "BIC"
+	case TypeIBAN:
+		return "IBAN"
+	case TypeValoren:
+		return "Valoren"
+	case TypeABA:
+		return "ABA"
+	case TypeISBN:
+		return "ISBN"
+	case TypeRIC:
+		return "RIC"
+	case TypeRED:
+		return "RED"
+	case TypeOSI:
+		return "OSI"
+	case TypePermID:
+		return "PermID"
+	case TypeEAN13:
+		return "EAN-13"
+	case TypeGTIN14:
+		return "GTIN-14"
+	case TypeCIK:
+		return "CIK"
+	case TypeDTC:
+		return "DTC participant"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler so the type is serialized by its name
+func (t Type) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing the names returned by String
+func (t *Type) UnmarshalText(text []byte) error {
+	name := string(text)
+	if name == TypeUnknown.String() {
+		*t = TypeUnknown
+		return nil
+	}
+	for kind := TypeISIN; kind.String() != TypeUnknown.String(); kind++ { //every type past the last is named Unknown
+		if kind.String() == name {
+			*t = kind
+			return nil
+		}
+	}
+	err := fmt.Errorf("Unknown type of identifier %q", name)
+	return err
+}
+
+// Identify takes a string containing a single identifier of unknown type, determines which type of identifier it is, and returns the type along with the cleaned identifier
+// The registered validators are tried from the most specific format to the least, so a FIGI is never reported as an ISIN.
+// Identifiers matched by custom validators are reported as TypeUnknown; use IdentifyValidator to find out which validator matched.
+func Identify(id string) (Type, string, error) {
+	v, clean, err := IdentifyValidator(id)
+	if err != nil {
+		return TypeUnknown, "", err
+	}
+	return typeOf(v), clean, nil
+}
+
+// IdentifyValidator takes a string containing a single identifier of unknown type and returns the first registered validator that accepts the whole string, along with the cleaned identifier
+func IdentifyValidator(id string) (Validator, string, error) {
+	trimmed := trim(id)
+	id = Normalize(id)
+	if id == "" {
+		err := fmt.Errorf("%w: Identifier is empty", ErrEmpty)
+		record(TypeUnknown, err)
+		return nil, "", err
+	}
+
+	for _, v := range Validators() {
+		clean, err := v.Validate(id)
+		if err != nil || clean != id { //the validators strip surrounding text, so make sure the whole string is the identifier
+			continue
+		}
+		if typeOf(v) == TypeISIN && isinDashes(trimmed) != nil { //the dashes were removed before validating it
+			continue
+		}
+		if typeOf(v) == TypeISIN && figiStructure(clean) == nil { //a FIGI with a bad check digit can still pass as an ISIN
+			continue
+		}
+		record(typeOf(v), nil)
+		return v, clean, nil
+	}
+
+	err := fmt.Errorf("Unable to identify the type of identifier. Provided: %s", provided(id))
+	record(TypeUnknown, err)
+	return nil, "", err
+}
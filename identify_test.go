@@ -0,0 +1,28 @@
+package identifiers
+
+import "testing"
+
+func TestIdentify(t *testing.T) {
+	tests := []struct {
+		id    string
+		kind  Type
+		clean string
+		ok    bool
+	}{
+		{"US0378331005", TypeISIN, "US0378331005", true},
+		{"037833100", TypeCUSIP, "037833100", true},
+		{"BBG000BLNNH6", TypeFIGI, "BBG000BLNNH6", true},
+		{"BBG000BLNNH7", TypeUnknown, "", false}, //a FIGI with a bad check digit isn't a Bloomberg ID standing in for an ISIN
+		{"BL$$$$$$$", TypeUnknown, "", false},    //nor is junk starting with BL a CUSIP
+		{"03783310", TypeUnknown, "", false},
+	}
+	for _, test := range tests {
+		kind, clean, err := Identify(test.id)
+		if kind != test.kind || clean != test.clean || (err == nil) != test.ok {
+			t.Errorf("Identify(%q) = %v, %q, %v; want %v, %q", test.id, kind, clean, err, test.kind, test.clean)
+		}
+		if result := Check(test.id); result.Valid != test.ok {
+			t.Errorf("Check(%q).Valid = %v; want %v", test.id, result.Valid, test.ok)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ISBN validates the ISBN-10 or ISBN-13 and returns it without hyphens or spaces
+// An ISBN-10 has a mod 11 check digit, which can be X for 10, and an ISBN-13 is an EAN-13 starting with 978 or 979.
+func ISBN(isbn string) (string, error) {
+	clean, err := validateISBN(isbn)
+	record(TypeISBN, err)
+	return clean, err
+}
+
+// validateISBN validates the ISBN without recording metrics
+func validateISBN(isbn string) (string, error) {
+	isbn = Normalize(isbn)
+	if isbn == "" {
+		err := emptyError(TypeISBN)
+		return "", err
+	}
+
+	switch len(isbn) {
+	case 10:
+		var sum int
+		for i, char := range isbn {
+			var value int
+			switch {
+			case char >= '0' && char <= '9':
+				value = int(char - '0')
+			case char == 'X' && i == 9:
+				value = 10
+			default:
+				err := invalidCharacter(TypeISBN, isbn, i, "ISBN-10 contains the invalid character '%c'", char)
+				return "", err
+			}
+			sum += (10 - i) * value //the weights count down from 10 to 1
+		}
+		if sum%11 != 0 {
+			err := fmt.Errorf("%w: ISBN-10 failed the mod 11 verification. Provided: %s", ErrBadChecksum, provided(isbn))
+			return "", err
+		}
+
+	case 13:
+		if !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+			err := fmt.Errorf("%w: ISBN-13 must start with 978 or 979. Provided: %s", ErrInvalidFormat, provided(isbn))
+			return "", err
+		}
+		for i, char := range isbn {
+			if char < '0' || char > '9' {
+				err := invalidCharacter(TypeISBN, isbn, i, "ISBN-13 contains the invalid character '%c'", char)
+				return "", err
+			}
+		}
+		if !validGTIN(isbn) {
+			err := fmt.Errorf("%w: ISBN-13 failed the mod 10 verification. Provided: %s", ErrBadChecksum, provided(isbn))
+			return "", err
+		}
+
+	default:
+		err := fmt.Errorf("%w: ISBN must be 10 or 13 characters long. Provided: %s", ErrInvalidLength, provided(isbn))
+		return "", err
+	}
+
+	return isbn, nil
+}
@@ -0,0 +1,77 @@
+package identifiers
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler, emitting the normalized ISIN as a JSON string
+func (v ISINValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(string(v), ISIN)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the ISIN. An empty string decodes to an empty ISINValue.
+// Validation failures wrap the package's sentinel errors, such as ErrBadChecksum.
+func (v *ISINValue) UnmarshalJSON(data []byte) error {
+	id, err := unmarshalJSON(data, string(*v), ISIN)
+	*v = ISINValue(id)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, emitting the normalized CUSIP as a JSON string
+func (v CUSIPValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(string(v), CUSIP)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the CUSIP. An empty string decodes to an empty CUSIPValue.
+// Validation failures wrap the package's sentinel errors, such as ErrBadChecksum.
+func (v *CUSIPValue) UnmarshalJSON(data []byte) error {
+	id, err := unmarshalJSON(data, string(*v), CUSIP)
+	*v = CUSIPValue(id)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler, emitting the normalized FIGI as a JSON string
+func (v FIGIValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(string(v), FIGI)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, validating the FIGI. An empty string decodes to an empty FIGIValue.
+// Validation failures wrap the package's sentinel errors, such as ErrBadChecksum.
+func (v *FIGIValue) UnmarshalJSON(data []byte) error {
+	id, err := unmarshalJSON(data, string(*v), FIGI)
+	*v = FIGIValue(id)
+	return err
+}
+
+// marshalJSON validates the identifier and encodes it as a JSON string
+func marshalJSON(id string, validator func(string) (string, error)) ([]byte, error) {
+	if id != "" {
+		var err error
+		id, err = validator(id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(id)
+}
+
+// unmarshalJSON decodes the JSON string and validates it
+// JSON null leaves the current identifier unchanged.
+func unmarshalJSON(data []byte, current string, validator func(string) (string, error)) (string, error) {
+	if string(data) == "null" {
+		return current, nil
+	}
+
+	var id string
+	err := json.Unmarshal(data, &id)
+	if err != nil {
+		return current, err
+	}
+	if id == "" {
+		return "", nil
+	}
+
+	id, err = validator(id)
+	if err != nil {
+		return current, err
+	}
+	return id, nil
+}
@@ -0,0 +1,69 @@
+package identifiers
+
+import "fmt"
+
+// LEI takes a string containing an LEI but possibly more than just the LEI, strips it, validates it is a real LEI, and returns just the LEI
+// An LEI is a 20-character Legal Entity Identifier (ISO 17442): 18 alphanumeric characters followed by 2 check digits.
+func LEI(lei string) (string, error) {
+	clean, err := validateLEI(lei)
+	record(TypeLEI, err)
+	return clean, err
+}
+
+// validateLEI validates the LEI without recording metrics
+func validateLEI(lei string) (string, error) {
+	lei = Normalize(lei)
+	if lei == "" {
+		err := emptyError(TypeLEI)
+		return "", err
+	}
+	if len(lei) < 20 {
+		err := fmt.Errorf("%w: LEI must be at least 20 characters long. Provided: %s", ErrTooShort, provided(lei))
+		return "", err
+	}
+	lei = lei[0:20]
+
+	for i, char := range lei {
+		if i >= 18 && (char < '0' || char > '9') {
+			err := invalidCharacter(TypeLEI, lei, i, "LEI check digits must be digits, found '%c'", char)
+			return "", err
+		}
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeLEI, lei, i, "LEI contains the invalid character '%c'", char)
+			return "", err
+		}
+	}
+
+	if mod97(lei) != 1 {
+		err := fmt.Errorf("%w: LEI failed the ISO 7064 mod 97-10 verification. Provided: %s", ErrBadChecksum, provided(lei))
+		return "", err
+	}
+
+	return lei, nil
+}
+
+// LEIParts are the structural components of an LEI
+type LEIParts struct {
+	LOU    string //4-character prefix of the Local Operating Unit that issued the LEI
+	Entity string //12-character entity-specific part
+	Check  string //2 check digits
+}
+
+// ParseLEI validates the LEI and splits it into its LOU prefix, entity-specific part, and check digits
+// Characters 5 and 6 are reserved and must be "00". Some LOUs have issued LEIs using them as part of the entity-specific part, which LEI accepts but ParseLEI does not.
+func ParseLEI(lei string) (LEIParts, error) {
+	lei, err := LEI(lei)
+	if err != nil {
+		return LEIParts{}, err
+	}
+	if lei[4:6] != "00" {
+		err := fmt.Errorf("%w: LEI characters 5 and 6 are reserved and must be 00. Provided: %s", ErrInvalidFormat, provided(lei))
+		return LEIParts{}, err
+	}
+
+	return LEIParts{
+		LOU:    lei[0:4],
+		Entity: lei[6:18],
+		Check:  lei[18:20],
+	}, nil
+}
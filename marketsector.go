@@ -0,0 +1,33 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarketSectors are the OpenFIGI market sectors a FIGIRecord can have
+var MarketSectors = []string{"Comdty", "Corp", "Curncy", "Equity", "Govt", "Index", "M-Mkt", "Mtge", "Muni", "Pfd"}
+
+// FIGIRecord is a FIGI along with the market sector OpenFIGI reports for it, which can't be derived from the FIGI itself
+type FIGIRecord struct {
+	FIGI         string
+	MarketSector string //one of MarketSectors
+}
+
+// NewFIGIRecord validates the FIGI and the market sector, matched case-insensitively against MarketSectors, and returns the record with both cleaned
+func NewFIGIRecord(figi, sector string) (FIGIRecord, error) {
+	figi, err := FIGI(figi)
+	if err != nil {
+		return FIGIRecord{}, err
+	}
+
+	sector = strings.TrimSpace(sector)
+	for _, known := range MarketSectors {
+		if strings.EqualFold(sector, known) {
+			return FIGIRecord{FIGI: figi, MarketSector: known}, nil
+		}
+	}
+
+	err = fmt.Errorf("%w: Market sector must be one of %s. Provided: %s", ErrUnknownCode, strings.Join(MarketSectors, ", "), sector)
+	return FIGIRecord{}, err
+}
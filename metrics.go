@@ -0,0 +1,41 @@
+package identifiers
+
+import "sync"
+
+// Metrics counts the outcomes of validations, for wiring up counters without the package importing a metrics library
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// IncValid is called when an identifier of the type passes validation
+	IncValid(kind Type)
+	// IncInvalid is called with the error when an identifier of the type fails validation
+	IncInvalid(kind Type, reason error)
+}
+
+var (
+	metricsMu sync.RWMutex
+	metrics   Metrics
+)
+
+// SetMetrics sets the Metrics the validators report to, or stops reporting if it is nil
+// Only validations requested directly are reported; the validations Identify, the finders, and the suggestion helpers try internally are not, so Identify reports just its outcome.
+func SetMetrics(m Metrics) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	metrics = m
+}
+
+// record reports the outcome of validating an identifier of the type to the Metrics, if set
+func record(kind Type, err error) {
+	metricsMu.RLock()
+	m := metrics
+	metricsMu.RUnlock()
+	if m == nil {
+		return
+	}
+
+	if err != nil {
+		m.IncInvalid(kind, err)
+		return
+	}
+	m.IncValid(kind)
+}
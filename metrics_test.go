@@ -0,0 +1,60 @@
+package identifiers
+
+import (
+	"sync"
+	"testing"
+)
+
+// countingMetrics counts the outcomes reported for each type
+type countingMetrics struct {
+	mu      sync.Mutex
+	valid   map[Type]int
+	invalid map[Type]int
+}
+
+func (m *countingMetrics) IncValid(kind Type) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.valid[kind]++
+}
+
+func (m *countingMetrics) IncInvalid(kind Type, reason error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.invalid[kind]++
+}
+
+func TestMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func() (string, error)
+		kind     Type
+	}{
+		{"FIGIExact empty", func() (string, error) { return FIGIExact("") }, TypeFIGI},
+		{"FIGIExact too long", func() (string, error) { return FIGIExact("BBG000BLNNH6X") }, TypeFIGI},
+		{"FIGIExact", func() (string, error) { return FIGIExact("BBG000BLNNH6") }, TypeFIGI},
+		{"BBGID", func() (string, error) { return BBGID("BBG000BLNNH6") }, TypeFIGI},
+		{"BBGID bad check digit", func() (string, error) { return BBGID("BBG000BLNNH7") }, TypeFIGI},
+		{"BBGID empty", func() (string, error) { return BBGID(" ") }, TypeFIGI},
+		{"ISIN", func() (string, error) { return ISIN("US0378331005") }, TypeISIN},
+		{"ISIN validating a Bloomberg ID", func() (string, error) {
+			ValidateBloombergIDs = true
+			defer func() { ValidateBloombergIDs = false }()
+			return ISIN("BBG000BLNNH7")
+		}, TypeISIN},
+	}
+	for _, test := range tests {
+		m := &countingMetrics{valid: map[Type]int{}, invalid: map[Type]int{}}
+		SetMetrics(m)
+		_, err := test.validate()
+		SetMetrics(nil)
+
+		outcomes := m.valid
+		if err != nil {
+			outcomes = m.invalid
+		}
+		if len(m.valid)+len(m.invalid) != 1 || outcomes[test.kind] != 1 {
+			t.Errorf("%s reported valid %v and invalid %v; want a single outcome for %v, with error %v", test.name, m.valid, m.invalid, test.kind, err)
+		}
+	}
+}
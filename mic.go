@@ -0,0 +1,82 @@
+package identifiers
+
+import "fmt"
+
+// KnownMICs are the operating Market Identifier Codes MIC accepts
+// The list only covers major venues; replace it with the full ISO 10383 list as needed, or set it to nil to only validate the format.
+var KnownMICs = map[string]bool{
+	"ARCX": true, //NYSE Arca
+	"BATS": true, //Cboe BZX
+	"BVMF": true, //B3
+	"XAMS": true, //Euronext Amsterdam
+	"XASE": true, //NYSE American
+	"XASX": true, //ASX
+	"XBOM": true, //BSE India
+	"XBRU": true, //Euronext Brussels
+	"XCBO": true, //Cboe Options
+	"XCME": true, //CME
+	"XCSE": true, //Nasdaq Copenhagen
+	"XDUB": true, //Euronext Dublin
+	"XETR": true, //Xetra
+	"XEUR": true, //Eurex
+	"XFRA": true, //Frankfurt
+	"XHEL": true, //Nasdaq Helsinki
+	"XHKG": true, //Hong Kong
+	"XJSE": true, //Johannesburg
+	"XKRX": true, //Korea
+	"XLIS": true, //Euronext Lisbon
+	"XLON": true, //London
+	"XMAD": true, //Madrid
+	"XMEX": true, //Mexico
+	"XMIL": true, //Borsa Italiana
+	"XNAS": true, //Nasdaq
+	"XNSE": true, //National Stock Exchange of India
+	"XNYS": true, //New York Stock Exchange
+	"XOSL": true, //Oslo
+	"XPAR": true, //Euronext Paris
+	"XSES": true, //Singapore
+	"XSHE": true, //Shenzhen
+	"XSHG": true, //Shanghai
+	"XSTO": true, //Nasdaq Stockholm
+	"XSWX": true, //SIX Swiss Exchange
+	"XTAE": true, //Tel Aviv
+	"XTKS": true, //Tokyo
+	"XTSE": true, //Toronto
+	"XWBO": true, //Vienna
+}
+
+// MIC takes a string containing a MIC but possibly more than just the MIC, strips it, validates it is a known MIC, and returns just the MIC
+// A MIC (ISO 10383) is a 4-character code that identifies a market; if KnownMICs is empty only the format is validated.
+func MIC(mic string) (string, error) {
+	clean, err := validateMIC(mic)
+	record(TypeMIC, err)
+	return clean, err
+}
+
+// validateMIC validates the MIC without recording metrics
+func validateMIC(mic string) (string, error) {
+	mic = Normalize(mic)
+	if mic == "" {
+		err := emptyError(TypeMIC)
+		return "", err
+	}
+	if len(mic) < 4 {
+		err := fmt.Errorf("%w: MIC must be at least 4 characters long. Provided: %s", ErrTooShort, provided(mic))
+		return "", err
+	}
+	mic = mic[0:4]
+
+	for i, char := range mic {
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeMIC, mic, i, "MIC must only contain letters and digits, found '%c'", char)
+			return "", err
+		}
+	}
+
+	if len(KnownMICs) > 0 && !KnownMICs[mic] {
+		err := fmt.Errorf("%w: MIC is not a known market. Provided: %s", ErrUnknownCode, provided(mic))
+		return "", err
+	}
+
+	return mic, nil
+}
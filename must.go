@@ -0,0 +1,27 @@
+package identifiers
+
+// MustISIN is ISIN for initialization and tests: it returns the cleaned ISIN, or panics if it is invalid
+// Don't use it on input from requests or files; use ISIN and handle the error instead.
+func MustISIN(isin string) string {
+	return must(ISIN(isin))
+}
+
+// MustCUSIP is CUSIP for initialization and tests: it returns the cleaned CUSIP, or panics if it is invalid
+// Don't use it on input from requests or files; use CUSIP and handle the error instead.
+func MustCUSIP(cusip string) string {
+	return must(CUSIP(cusip))
+}
+
+// MustFIGI is FIGI for initialization and tests: it returns the cleaned FIGI, or panics if it is invalid
+// Don't use it on input from requests or files; use FIGI and handle the error instead.
+func MustFIGI(figi string) string {
+	return must(FIGI(figi))
+}
+
+// must panics with the error if there is one, otherwise it returns the identifier
+func must(id string, err error) string {
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
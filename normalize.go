@@ -0,0 +1,85 @@
+package identifiers
+
+import (
+	"strings"
+	"unicode"
+)
+
+// separators are removed from identifiers during normalization, along with the invisible characters copying from web pages adds
+var separators = strings.NewReplacer(
+	" ", "",
+	"-", "",
+	"\u00A0", "", //non-breaking space
+	"\u200B", "", //zero-width space
+	"\u200C", "", //zero-width non-joiner
+	"\u200D", "", //zero-width joiner
+	"\u2060", "", //word joiner
+)
+
+// bom is the UTF-8 byte order mark some CSV exports start the first field with
+const bom = "\uFEFF"
+
+// Labels are the vendor labels StripLabel removes from the start of an identifier, matched case-insensitively
+// Add to it to recognize other vendors' labels.
+var Labels = []string{"ISIN", "CUSIP", "FIGI", "SEDOL", "LEI", "CINS", "WKN", "MIC", "BIC", "SWIFT", "IBAN", "VALOREN", "ABA", "ISBN", "RIC", "CIK"}
+
+// Normalize cleans up a candidate identifier without validating it: surrounding whitespace, quotes, brackets, a leading byte order mark, and a leading label are trimmed, letters are uppercased, and spaces (including non-breaking spaces), zero-width characters, and dashes are removed
+// All of the validators normalize their input with it first.
+func Normalize(id string) string {
+	return separators.Replace(strings.ToUpper(trim(id)))
+}
+
+// trim trims the surrounding whitespace, quotes, brackets, byte order mark, and label from the identifier, without allocating
+func trim(id string) string {
+	id = strings.TrimPrefix(strings.TrimSpace(id), bom)
+	id = strings.TrimPrefix(strings.Trim(id, `"'`), bom) //the BOM can be inside or outside the quotes
+	id = stripBrackets(strings.TrimSpace(id))
+	return strings.TrimSpace(StripLabel(id))
+}
+
+// brackets are the pairs of brackets stripBrackets removes, keyed by the opening bracket
+var brackets = map[byte]byte{'(': ')', '[': ']', '{': '}', '<': '>'}
+
+// stripBrackets removes a single layer of matching brackets surrounding the identifier, such as "(US0378331005)"
+func stripBrackets(id string) string {
+	if len(id) < 2 {
+		return id
+	}
+	if closing, ok := brackets[id[0]]; ok && id[len(id)-1] == closing {
+		return strings.TrimSpace(id[1 : len(id)-1])
+	}
+	return id
+}
+
+// StripLabel removes a recognized label followed by a ':', '=', or whitespace from the start of the string, e.g. "ISIN: US0378331005" or "sedol=0263494", and returns the rest
+// The label must be followed by one of the separators so identifiers that happen to start with a label aren't cut short. Strings without a label are returned unchanged.
+func StripLabel(s string) string {
+	trimmed := strings.TrimLeftFunc(s, unicode.IsSpace)
+	for _, label := range Labels {
+		if len(trimmed) <= len(label) || !strings.EqualFold(trimmed[:len(label)], label) {
+			continue
+		}
+		rest := trimmed[len(label):]
+		candidate := strings.TrimLeftFunc(rest, labelSeparator)
+		if len(candidate) == len(rest) { //no separator, so the label is part of the identifier
+			continue
+		}
+		return candidate
+	}
+	return s
+}
+
+// labelSeparator reports whether the character can separate a label from the identifier
+func labelSeparator(char rune) bool {
+	return char == ':' || char == '=' || unicode.IsSpace(char)
+}
+
+// digitsOnly removes everything but the digits from the string
+func digitsOnly(str string) string {
+	return strings.Map(func(char rune) rune {
+		if char < '0' || char > '9' {
+			return -1
+		}
+		return char
+	}, str)
+}
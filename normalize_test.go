@@ -0,0 +1,62 @@
+package identifiers
+
+import "testing"
+
+func TestNormalizeQuotes(t *testing.T) {
+	tests := []string{
+		`"US0378331005"`,
+		`'US0378331005'`,
+		"\uFEFFUS0378331005",
+		"\uFEFF\"US0378331005\"",
+		"\"\uFEFFUS0378331005\"",
+	}
+	for _, isin := range tests {
+		got, err := ISIN(isin)
+		if got != "US0378331005" || err != nil {
+			t.Errorf("ISIN(%q) = %q, %v; want %q", isin, got, err, "US0378331005")
+		}
+	}
+}
+
+func TestNormalizeBrackets(t *testing.T) {
+	tests := []struct {
+		validator func(string) (string, error)
+		id        string
+		want      string
+	}{
+		{ISIN, "(US0378331005)", "US0378331005"},
+		{ISIN, "[ US0378331005 ]", "US0378331005"},
+		{ISIN, "\"{US0378331005}\"", "US0378331005"},
+		{CUSIP, "[037833100]", "037833100"},
+		{CUSIP, "<037833100>", "037833100"},
+	}
+	for _, test := range tests {
+		got, err := test.validator(test.id)
+		if got != test.want || err != nil {
+			t.Errorf("%q = %q, %v; want %q", test.id, got, err, test.want)
+		}
+	}
+	if got := Normalize("(US0378331005]"); got != "(US0378331005]" { //only matching brackets are removed
+		t.Errorf("Normalize(%q) = %q; want it unchanged", "(US0378331005]", got)
+	}
+}
+
+func TestNormalizeInvisible(t *testing.T) {
+	tests := []struct {
+		validator func(string) (string, error)
+		id        string
+		want      string
+	}{
+		{ISIN, "US\u00A00378331005", "US0378331005"}, //non-breaking space
+		{ISIN, "US0378331005\u00A0", "US0378331005"},
+		{ISIN, "\u2060US037833\u200D1005", "US0378331005"}, //word joiner and zero-width joiner
+		{CUSIP, "0378\u200B33100", "037833100"},            //zero-width space
+		{CUSIP, "\u200B037833100\u200C", "037833100"},
+	}
+	for _, test := range tests {
+		got, err := test.validator(test.id)
+		if got != test.want || err != nil {
+			t.Errorf("%q = %q, %v; want %q", test.id, got, err, test.want)
+		}
+	}
+}
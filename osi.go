@@ -0,0 +1,78 @@
+package identifiers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OSIParts are the components of an OSI option symbol
+type OSIParts struct {
+	Root       string    //1 to 6-character symbol of the underlying, without its padding
+	Expiration time.Time //expiration date, in UTC
+	Type       byte      //'C' for a call or 'P' for a put
+	Strike     float64   //strike price
+}
+
+// OSI validates the 21-character OCC option symbol (the OSI format) and splits it into its root, expiration date, call or put type, and strike price
+// The symbol is the root padded with spaces to 6 characters, the expiration as YYMMDD, C or P, and the strike price times 1000 as 8 digits, e.g. "AAPL  210917C00150000".
+// The root's padding can be left out.
+func OSI(osi string) (OSIParts, error) {
+	osi = strings.ToUpper(strings.TrimSpace(osi))
+	if osi == "" {
+		err := emptyError(TypeOSI)
+		return OSIParts{}, err
+	}
+	if len(osi) < 16 {
+		err := fmt.Errorf("%w: OSI symbol must be at least 16 characters long. Provided: %s", ErrTooShort, provided(osi))
+		return OSIParts{}, err
+	}
+	if len(osi) > 21 {
+		err := fmt.Errorf("%w: OSI symbol must be at most 21 characters long. Provided: %s", ErrInvalidLength, provided(osi))
+		return OSIParts{}, err
+	}
+	fixed := len(osi) - 15 //the expiration, type, and strike are a fixed 15 characters at the end
+
+	root := strings.TrimRight(osi[:fixed], " ")
+	if len(root) == 0 {
+		err := fmt.Errorf("%w: OSI symbol is missing its root. Provided: %s", ErrInvalidFormat, provided(osi))
+		return OSIParts{}, err
+	}
+	for i, char := range root {
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeOSI, osi, i, "OSI root must only contain letters and digits, found '%c'", char)
+			return OSIParts{}, err
+		}
+	}
+
+	expiration, err := time.Parse("060102", osi[fixed:fixed+6])
+	if err != nil {
+		err := fmt.Errorf("%w: OSI expiration must be a valid YYMMDD date. Provided: %s", ErrInvalidFormat, provided(osi))
+		return OSIParts{}, err
+	}
+
+	kind := osi[fixed+6]
+	if kind != 'C' && kind != 'P' {
+		err := invalidCharacter(TypeOSI, osi, fixed+6, "OSI type must be C for a call or P for a put, found '%c'", kind)
+		return OSIParts{}, err
+	}
+
+	for i, char := range osi[fixed+7:] {
+		if char < '0' || char > '9' {
+			err := invalidCharacter(TypeOSI, osi, fixed+7+i, "OSI strike price must be 8 digits, found '%c'", char)
+			return OSIParts{}, err
+		}
+	}
+	strike, err := strconv.Atoi(osi[fixed+7:])
+	if err != nil {
+		return OSIParts{}, err
+	}
+
+	return OSIParts{
+		Root:       root,
+		Expiration: expiration,
+		Type:       kind,
+		Strike:     float64(strike) / 1000,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// permIDPrefixes are the prefixes PermID strips, matched case-insensitively
+var permIDPrefixes = []string{"https://permid.org/1-", "http://permid.org/1-", "permid.org/1-", "permid:"}
+
+// PermID strips a "permid:" or permid.org URL prefix from the Refinitiv Permanent Identifier, validates it, and returns the numeric PermID
+// A PermID is a number of 8 to 15 digits; it has no public check digit so only the format is validated.
+func PermID(permID string) (string, error) {
+	clean, err := validatePermID(permID)
+	record(TypePermID, err)
+	return clean, err
+}
+
+// validatePermID validates the PermID without recording metrics
+func validatePermID(permID string) (string, error) {
+	id := strings.TrimSpace(permID)
+	for _, prefix := range permIDPrefixes {
+		if len(id) >= len(prefix) && strings.EqualFold(id[:len(prefix)], prefix) {
+			id = strings.TrimSpace(id[len(prefix):])
+			break
+		}
+	}
+	if id == "" {
+		err := emptyError(TypePermID)
+		return "", err
+	}
+
+	if len(id) < 8 {
+		err := fmt.Errorf("%w: PermID must be at least 8 digits long. Provided: %s", ErrTooShort, provided(permID))
+		return "", err
+	}
+	if len(id) > 15 {
+		err := fmt.Errorf("%w: PermID cannot be more than 15 digits long. Provided: %s", ErrInvalidLength, provided(permID))
+		return "", err
+	}
+	for i, char := range id {
+		if char < '0' || char > '9' {
+			err := invalidCharacter(TypePermID, id, i, "PermID must only contain digits, found '%c'", char)
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+// PermIDURL validates the PermID and returns its canonical permid.org URL
+func PermIDURL(permID string) (string, error) {
+	permID, err := PermID(permID)
+	if err != nil {
+		return "", err
+	}
+	return "https://permid.org/1-" + permID, nil
+}
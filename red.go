@@ -0,0 +1,55 @@
+package identifiers
+
+import "fmt"
+
+// The kinds of RED codes returned by REDKind
+const (
+	REDEntity = "entity" //6-character reference entity code
+	REDPair   = "pair"   //9-character reference obligation pair code: the entity code followed by 3 characters for the obligation
+)
+
+// RED validates the Markit RED code and returns it normalized
+// A RED code is a 6-character reference entity code or a 9-character pair code of letters and digits other than I and O; it has no public check digit so only the format is validated.
+func RED(red string) (string, error) {
+	clean, err := validateRED(red)
+	record(TypeRED, err)
+	return clean, err
+}
+
+// validateRED validates the RED code without recording metrics
+func validateRED(red string) (string, error) {
+	red = Normalize(red)
+	if red == "" {
+		err := emptyError(TypeRED)
+		return "", err
+	}
+	if len(red) != 6 && len(red) != 9 {
+		err := fmt.Errorf("%w: RED code must be 6 or 9 characters long. Provided: %s", ErrInvalidLength, provided(red))
+		return "", err
+	}
+
+	for i, char := range red {
+		if char == 'I' || char == 'O' { //not used to avoid confusion with 1 and 0
+			err := invalidCharacter(TypeRED, red, i, "RED code cannot contain the letter '%c'", char)
+			return "", err
+		}
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeRED, red, i, "RED code must only contain letters and digits, found '%c'", char)
+			return "", err
+		}
+	}
+
+	return red, nil
+}
+
+// REDKind validates the RED code and returns whether it is an entity code (REDEntity) or a pair code (REDPair)
+func REDKind(red string) (string, error) {
+	red, err := RED(red)
+	if err != nil {
+		return "", err
+	}
+	if len(red) == 6 {
+		return REDEntity, nil
+	}
+	return REDPair, nil
+}
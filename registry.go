@@ -0,0 +1,125 @@
+package identifiers
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Validator validates one type of identifier
+type Validator interface {
+	// Name returns the name of the type of identifier
+	Name() string
+	// Validate validates the identifier and returns just the identifier
+	Validate(id string) (string, error)
+}
+
+// NewValidator returns a Validator with the name that validates identifiers with the function, for registering custom types of identifiers
+func NewValidator(name string, validate func(string) (string, error)) Validator {
+	return funcValidator{name: name, validate: validate}
+}
+
+// funcValidator is a Validator backed by a function
+type funcValidator struct {
+	name     string
+	validate func(string) (string, error)
+}
+
+func (v funcValidator) Name() string {
+	return v.name
+}
+
+func (v funcValidator) Validate(id string) (string, error) {
+	return v.validate(id)
+}
+
+// typeValidator is the Validator for one of the package's types of identifiers
+type typeValidator struct {
+	kind     Type
+	validate func(string) (string, error)
+}
+
+func (v typeValidator) Name() string {
+	return v.kind.String()
+}
+
+func (v typeValidator) Validate(id string) (string, error) {
+	return v.validate(id)
+}
+
+// typeOf returns the type of identifier the validator validates, or TypeUnknown for custom validators
+func typeOf(v Validator) Type {
+	if v, ok := v.(typeValidator); ok {
+		return v.kind
+	}
+	return TypeUnknown
+}
+
+// typeValidators are the validators for each of the package's types of identifiers, without recording metrics
+var typeValidators = map[Type]func(string) (string, error){
+	TypeISIN:    func(isin string) (string, error) { return validateISIN(isin, false) },
+	TypeCUSIP:   func(cusip string) (string, error) { return validateCUSIP(cusip, false) },
+	TypeFIGI:    validateFIGI,
+	TypeSEDOL:   validateSEDOL,
+	TypeLEI:     validateLEI,
+	TypeCINS:    validateCINS,
+	TypeWKN:     validateWKN,
+	TypeMIC:     validateMIC,
+	TypeBIC:     validateBIC,
+	TypeIBAN:    validateIBAN,
+	TypeValoren: validateValoren,
+	TypeABA:     validateABA,
+	TypeISBN:    validateISBN,
+	TypeRED:     validateRED,
+	TypePermID:  validatePermID,
+	TypeEAN13:   func(ean string) (string, error) { return gtin(TypeEAN13, "EAN-13", ean, 13) },
+	TypeGTIN14:  func(gtin14 string) (string, error) { return gtin(TypeGTIN14, "GTIN-14", gtin14, 14) },
+	TypeCIK:     validateCIK,
+	TypeDTC:     validateDTCParticipant,
+}
+
+// Validate validates the identifier with the validator for the type, for when the type comes from metadata such as a column header
+// Types without a validator, such as TypeUnknown, return an error.
+func Validate(kind Type, s string) (string, error) {
+	validator, ok := typeValidators[kind]
+	if !ok {
+		err := fmt.Errorf("Unable to validate identifiers of type %s", kind)
+		return "", err
+	}
+	clean, err := validator(s)
+	record(kind, err)
+	return clean, err
+}
+
+// isinStrict and cusipStrict are ISINStrict and CUSIPStrict without recording metrics, for trying many candidates
+func isinStrict(isin string) (string, error) {
+	return validateISIN(isin, true)
+}
+
+func cusipStrict(cusip string) (string, error) {
+	return validateCUSIP(cusip, true)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = []Validator{ //ordered from the most specific format to the least
+		typeValidator{TypeFIGI, typeValidators[TypeFIGI]},
+		typeValidator{TypeISIN, isinStrict}, //strict, so Bloomberg IDs, corrupt FIGIs, and CUSIPs missing their check digit aren't identified
+		typeValidator{TypeCUSIP, cusipStrict},
+		typeValidator{TypeSEDOL, typeValidators[TypeSEDOL]},
+	}
+)
+
+// Register adds the validator to the registry, after the validators already registered
+// It is safe to call concurrently, but is usually called from an init function.
+func Register(v Validator) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry = append(registry, v)
+}
+
+// Validators returns the registered validators in the order Identify tries them
+func Validators() []Validator {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]Validator(nil), registry...)
+}
@@ -0,0 +1,33 @@
+package identifiers
+
+// Result is the outcome of validating one identifier, ready to be serialized to JSON
+type Result struct {
+	Input string `json:"input"` //identifier as provided
+	Clean string `json:"clean"` //cleaned identifier, empty if it failed validation
+	Type  Type   `json:"type"`
+	Valid bool   `json:"valid"`
+	Error string `json:"error"` //message of Err, empty if valid
+	Err   error  `json:"-"`
+}
+
+// Check identifies the type of the identifier and returns the outcome as a Result
+func Check(s string) Result {
+	kind, clean, err := Identify(s)
+	return newResult(s, kind, clean, err)
+}
+
+// newResult returns the Result of validating the input as the type of identifier
+func newResult(input string, kind Type, clean string, err error) Result {
+	result := Result{
+		Input: input,
+		Clean: clean,
+		Type:  kind,
+		Valid: err == nil,
+		Err:   err,
+	}
+	if err != nil {
+		result.Clean = ""
+		result.Error = err.Error()
+	}
+	return result
+}
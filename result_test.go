@@ -0,0 +1,39 @@
+package identifiers
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestResultJSON(t *testing.T) {
+	for _, s := range []string{"US0378331005", "BBG000BLNNH6", "US0378331004", ""} {
+		want := Check(s)
+		want.Err = nil //not serialized
+
+		data, err := json.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Result
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s) = %v", data, err)
+		}
+		if got != want {
+			t.Errorf("json.Unmarshal(%s) = %+v; want %+v", data, got, want)
+		}
+	}
+}
+
+func TestTypeText(t *testing.T) {
+	for kind := TypeUnknown; kind <= TypeDTC; kind++ {
+		text, _ := kind.MarshalText()
+		var got Type
+		if err := got.UnmarshalText(text); err != nil || got != kind {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v", text, got, err, kind)
+		}
+	}
+	var got Type
+	if err := got.UnmarshalText([]byte("Ticker")); err == nil {
+		t.Errorf("UnmarshalText(%q) = %v; want an error", "Ticker", got)
+	}
+}
@@ -0,0 +1,43 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseRIC validates the RIC is a simple instrument RIC and splits it into its root and exchange suffix, e.g. "AAPL.O" is root "AAPL" and suffix "O"
+// A RIC (Reuters Instrument Code) has no check digit so only the format is validated. Chain and continuation RICs such as "0#.SPX" are errors, as are RICs without a suffix.
+func ParseRIC(ric string) (root, suffix string, err error) {
+	ric = strings.ToUpper(strings.TrimSpace(ric))
+	if ric == "" {
+		err := emptyError(TypeRIC)
+		return "", "", err
+	}
+	if strings.Contains(ric, "#") {
+		err := fmt.Errorf("%w: RIC is a chain or continuation RIC rather than an instrument RIC. Provided: %s", ErrInvalidFormat, provided(ric))
+		return "", "", err
+	}
+
+	dot := strings.IndexByte(ric, '.')
+	if dot < 0 {
+		err := fmt.Errorf("%w: RIC must have an exchange suffix after a dot. Provided: %s", ErrInvalidFormat, provided(ric))
+		return "", "", err
+	}
+	root, suffix = ric[:dot], ric[dot+1:]
+	if root == "" || suffix == "" {
+		err := fmt.Errorf("%w: RIC must have both a root and an exchange suffix. Provided: %s", ErrInvalidFormat, provided(ric))
+		return "", "", err
+	}
+
+	for i, char := range ric {
+		if i == dot {
+			continue
+		}
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeRIC, ric, i, "RIC must only contain letters and digits around a single dot, found '%c'", char)
+			return "", "", err
+		}
+	}
+
+	return root, suffix, nil
+}
@@ -0,0 +1,39 @@
+package identifiers
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// ScanISINs reads one ISIN per line from the reader and calls fn with the result of validating each, skipping blank lines
+// It returns any error reading from the reader.
+func ScanISINs(r io.Reader, fn func(isin string, err error)) error {
+	return scanLines(r, ISIN, fn)
+}
+
+// ScanCUSIPs reads one CUSIP per line from the reader and calls fn with the result of validating each, skipping blank lines
+// It returns any error reading from the reader.
+func ScanCUSIPs(r io.Reader, fn func(cusip string, err error)) error {
+	return scanLines(r, CUSIP, fn)
+}
+
+// ScanFIGIs reads one FIGI per line from the reader and calls fn with the result of validating each, skipping blank lines
+// It returns any error reading from the reader.
+func ScanFIGIs(r io.Reader, fn func(figi string, err error)) error {
+	return scanLines(r, FIGI, fn)
+}
+
+// scanLines validates each non-blank line of the reader and calls fn with the result
+// Lines ending in CRLF are handled by bufio.ScanLines dropping the carriage return.
+func scanLines(r io.Reader, validator func(string) (string, error), fn func(string, error)) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fn(validator(line))
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,91 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sedolWeights are the weights applied to each of the 7 SEDOL characters for the check digit
+var sedolWeights = [7]int{1, 3, 1, 7, 3, 9, 1}
+
+// SEDOL takes a string containing a SEDOL but possibly more than just the SEDOL, strips it, validates it is a real SEDOL, and returns just the SEDOL
+// A SEDOL is a 7-character code assigned by the London Stock Exchange; the last character is a check digit.
+func SEDOL(sedol string) (string, error) {
+	clean, err := validateSEDOL(sedol)
+	record(TypeSEDOL, err)
+	return clean, err
+}
+
+// validateSEDOL validates the SEDOL without recording metrics
+func validateSEDOL(sedol string) (string, error) {
+	sedol = Normalize(sedol)
+	if sedol == "" {
+		err := emptyError(TypeSEDOL)
+		return "", err
+	}
+	if len(sedol) < 7 {
+		err := fmt.Errorf("%w: SEDOL must be at least 7 characters long. Provided: %s", ErrTooShort, provided(sedol))
+		return "", err
+	}
+	sedol = sedol[0:7]
+
+	err := sedolCharacters(sedol)
+	if err != nil {
+		return "", err
+	}
+	if sedol[6] < '0' || sedol[6] > '9' {
+		err := invalidCharacter(TypeSEDOL, sedol, 6, "SEDOL check digit must be a digit, found '%c'", sedol[6])
+		return "", err
+	}
+
+	if WeightedMod10(sedol[:6], sedolWeights[:6], sedolValue) != int(sedol[6]-'0') {
+		err := fmt.Errorf("%w: SEDOL failed the weighted check digit verification. Provided: %s", ErrBadChecksum, provided(sedol))
+		return "", err
+	}
+
+	return sedol, nil
+}
+
+// ValidSEDOL reports whether the string starts with a valid SEDOL
+func ValidSEDOL(sedol string) bool {
+	_, err := SEDOL(sedol)
+	return err == nil
+}
+
+// SEDOLCheckDigit computes the check digit for the 6-character SEDOL base
+func SEDOLCheckDigit(body string) (byte, error) {
+	if len(body) != 6 {
+		err := fmt.Errorf("%w: SEDOL base must be 6 characters long. Provided: %s", ErrInvalidLength, provided(body))
+		return 0, err
+	}
+
+	err := sedolCharacters(body)
+	if err != nil {
+		return 0, err
+	}
+
+	return byte(WeightedMod10(body, sedolWeights[:6], sedolValue)) + '0', nil
+}
+
+// sedolCharacters checks the SEDOL, or SEDOL base, only contains digits and consonants
+func sedolCharacters(sedol string) error {
+	for i, char := range sedol {
+		if strings.ContainsRune("AEIOU", char) { //modern SEDOLs never contain vowels
+			err := invalidCharacter(TypeSEDOL, sedol, i, "SEDOL cannot contain the vowel '%c'", char)
+			return err
+		}
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeSEDOL, sedol, i, "SEDOL contains the invalid character '%c'", char)
+			return err
+		}
+	}
+	return nil
+}
+
+// sedolValue returns the value of a SEDOL character: digits are their own value and the letter B is 11, C is 12, etc.
+func sedolValue(char rune) int {
+	if char >= '0' && char <= '9' {
+		return int(char - '0')
+	}
+	return int(char-'A') + 10
+}
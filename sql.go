@@ -0,0 +1,65 @@
+package identifiers
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Scan implements sql.Scanner, validating the ISIN. NULL scans to an empty ISINValue.
+func (v *ISINValue) Scan(src any) error {
+	id, err := scan(src, ISIN)
+	*v = ISINValue(id)
+	return err
+}
+
+// Value implements driver.Valuer, returning the normalized ISIN. An empty ISINValue is stored as NULL.
+func (v ISINValue) Value() (driver.Value, error) {
+	return value(string(v), ISIN)
+}
+
+// Scan implements sql.Scanner, validating the CUSIP. NULL scans to an empty CUSIPValue.
+func (v *CUSIPValue) Scan(src any) error {
+	id, err := scan(src, CUSIP)
+	*v = CUSIPValue(id)
+	return err
+}
+
+// Value implements driver.Valuer, returning the normalized CUSIP. An empty CUSIPValue is stored as NULL.
+func (v CUSIPValue) Value() (driver.Value, error) {
+	return value(string(v), CUSIP)
+}
+
+// Scan implements sql.Scanner, validating the FIGI. NULL scans to an empty FIGIValue.
+func (v *FIGIValue) Scan(src any) error {
+	id, err := scan(src, FIGI)
+	*v = FIGIValue(id)
+	return err
+}
+
+// Value implements driver.Valuer, returning the normalized FIGI. An empty FIGIValue is stored as NULL.
+func (v FIGIValue) Value() (driver.Value, error) {
+	return value(string(v), FIGI)
+}
+
+// scan converts the database value to a string and validates it
+func scan(src any, validator func(string) (string, error)) (string, error) {
+	switch src := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return validator(src)
+	case []byte:
+		return validator(string(src))
+	default:
+		err := fmt.Errorf("Cannot scan %T into an identifier", src)
+		return "", err
+	}
+}
+
+// value validates the identifier and returns it as a database value, or NULL if it is empty
+func value(id string, validator func(string) (string, error)) (driver.Value, error) {
+	if id == "" {
+		return nil, nil
+	}
+	return validator(id)
+}
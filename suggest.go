@@ -0,0 +1,94 @@
+package identifiers
+
+import (
+	"bytes"
+	"strings"
+)
+
+// cusipAlphabet are all of the characters allowed in a CUSIP
+const cusipAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ" + cusipSpecialChars
+
+// SuggestCUSIP suggests a correction for a 9-character CUSIP that fails its check digit, for data entry tools
+// Swapping adjacent characters is tried first, as transpositions are the most common mistake, then changing a single character, starting with the check digit.
+// Changing the check digit passes whenever the rest of the CUSIP is valid, so the other characters are only changed to replace an invalid character.
+// A valid CUSIP is returned unchanged. The suggestion is a plausible correction, not the certain one.
+func SuggestCUSIP(s string) (string, bool) {
+	cusip := strings.ReplaceAll(Normalize(s), ".", "")
+	if len(cusip) != 9 {
+		return "", false
+	}
+	if _, err := cusipStrict(cusip); err == nil {
+		return cusip, true
+	}
+
+	candidate := []byte(cusip)
+	for i := 0; i < len(candidate)-1; i++ {
+		candidate[i], candidate[i+1] = candidate[i+1], candidate[i]
+		if _, err := cusipStrict(string(candidate)); err == nil {
+			return string(candidate), true
+		}
+		candidate[i], candidate[i+1] = candidate[i+1], candidate[i]
+	}
+
+	for i := len(candidate) - 1; i >= 0; i-- { //from the check digit back
+		original := candidate[i]
+		for j := 0; j < len(cusipAlphabet); j++ {
+			if cusipAlphabet[j] == original {
+				continue
+			}
+			candidate[i] = cusipAlphabet[j]
+			if _, err := cusipStrict(string(candidate)); err == nil {
+				return string(candidate), true
+			}
+		}
+		candidate[i] = original
+	}
+
+	return "", false
+}
+
+// maxWildcards is the most placeholders CompleteWildcard fills in, keeping the candidates to at most 39^2
+const maxWildcards = 2
+
+// CompleteWildcard fills in the '?' placeholders of the identifier with every character allowed in a CUSIP, which covers the other types, and returns the candidates that are valid identifiers of the type, for recovering unreadable characters
+// ISINs and CUSIPs are validated strictly, as Bloomberg IDs and CUSIPs missing their check digit would match every candidate.
+// It returns nil if there are no placeholders, more than 2, or the type can't be validated.
+func CompleteWildcard(pattern string, kind Type) []string {
+	validator, ok := typeValidators[kind]
+	if !ok {
+		return nil
+	}
+	switch kind {
+	case TypeISIN:
+		validator = isinStrict
+	case TypeCUSIP:
+		validator = cusipStrict
+	}
+	pattern = Normalize(pattern)
+	wildcards := strings.Count(pattern, "?")
+	if wildcards == 0 || wildcards > maxWildcards {
+		return nil
+	}
+
+	var found []string
+	var fill func(candidate []byte, from int)
+	fill = func(candidate []byte, from int) {
+		i := bytes.IndexByte(candidate[from:], '?')
+		if i < 0 {
+			id, err := validator(string(candidate))
+			if err == nil && id == string(candidate) { //the whole candidate must be the identifier
+				found = append(found, id)
+			}
+			return
+		}
+		i += from
+		for j := 0; j < len(cusipAlphabet); j++ {
+			candidate[i] = cusipAlphabet[j]
+			fill(candidate, i+1)
+		}
+		candidate[i] = '?'
+	}
+	fill([]byte(pattern), 0)
+
+	return found
+}
@@ -0,0 +1,51 @@
+package identifiers
+
+import "testing"
+
+func TestSuggestCUSIP(t *testing.T) {
+	tests := []struct {
+		cusip string
+		want  string
+		ok    bool
+	}{
+		{"037833100", "037833100", true},
+		{"073833100", "037833100", true}, //transposed
+		{"037834100", "037834108", true}, //a mistyped character in the body can't be told apart from a wrong check digit
+		{"0378!3100", "037833100", true}, //the invalid character is replaced
+		{"!378!3100", "", false},
+		{"03783310", "", false},
+	}
+	for _, test := range tests {
+		got, ok := SuggestCUSIP(test.cusip)
+		if got != test.want || ok != test.ok {
+			t.Errorf("SuggestCUSIP(%q) = %q, %v; want %q, %v", test.cusip, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestCompleteWildcard(t *testing.T) {
+	tests := []struct {
+		pattern string
+		kind    Type
+		want    []string
+	}{
+		{"03783310?", TypeCUSIP, []string{"037833100"}},
+		{"0378331?", TypeCUSIP, nil}, //8-character CUSIPs are missing the check digit that narrows the candidates
+		{"US037833100?", TypeISIN, []string{"US0378331005"}},
+		{"BBG???????", TypeISIN, nil},
+		{"037833100", TypeCUSIP, nil},
+		{"0?78?31?0", TypeCUSIP, nil},
+	}
+	for _, test := range tests {
+		got := CompleteWildcard(test.pattern, test.kind)
+		if len(got) != len(test.want) || (len(got) > 0 && got[0] != test.want[0]) {
+			t.Errorf("CompleteWildcard(%q, %v) = %q; want %q", test.pattern, test.kind, got, test.want)
+		}
+	}
+
+	for _, cusip := range CompleteWildcard("BL?234567", TypeCUSIP) { //Bloomberg IDs would match every candidate
+		if !Modulus10DoubleAddDouble(cusip) {
+			t.Errorf("CompleteWildcard(%q, %v) returned %q, which fails its check digit", "BL?234567", TypeCUSIP, cusip)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package identifiers
+
+// MarshalText implements encoding.TextMarshaler, emitting the normalized ISIN
+func (v ISINValue) MarshalText() ([]byte, error) {
+	return marshalText(string(v), ISIN)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the ISIN. Empty text decodes to an empty ISINValue.
+func (v *ISINValue) UnmarshalText(text []byte) error {
+	id, err := unmarshalText(text, ISIN)
+	if err != nil {
+		return err
+	}
+	*v = ISINValue(id)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the normalized CUSIP
+func (v CUSIPValue) MarshalText() ([]byte, error) {
+	return marshalText(string(v), CUSIP)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the CUSIP. Empty text decodes to an empty CUSIPValue.
+func (v *CUSIPValue) UnmarshalText(text []byte) error {
+	id, err := unmarshalText(text, CUSIP)
+	if err != nil {
+		return err
+	}
+	*v = CUSIPValue(id)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the normalized FIGI
+func (v FIGIValue) MarshalText() ([]byte, error) {
+	return marshalText(string(v), FIGI)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the FIGI. Empty text decodes to an empty FIGIValue.
+func (v *FIGIValue) UnmarshalText(text []byte) error {
+	id, err := unmarshalText(text, FIGI)
+	if err != nil {
+		return err
+	}
+	*v = FIGIValue(id)
+	return nil
+}
+
+// marshalText validates the identifier and returns it as text
+func marshalText(id string, validator func(string) (string, error)) ([]byte, error) {
+	if id == "" {
+		return []byte{}, nil
+	}
+	id, err := validator(id)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(id), nil
+}
+
+// unmarshalText validates the identifier in the text
+func unmarshalText(text []byte, validator func(string) (string, error)) (string, error) {
+	if len(text) == 0 {
+		return "", nil
+	}
+	return validator(string(text))
+}
@@ -0,0 +1,60 @@
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Valoren takes a string containing a Valoren, strips its separators, validates it is a plausible Valoren, and returns it without leading zeros
+// A Valoren (Swiss security number) is a number of up to 12 digits assigned by SIX; it has no check digit so only the range is validated.
+// Digits can be grouped with spaces, dashes, dots, or apostrophes, as in 1'203'204, but anything else is an invalid character.
+func Valoren(valoren string) (string, error) {
+	clean, err := validateValoren(valoren)
+	record(TypeValoren, err)
+	return clean, err
+}
+
+// valorenSeparators are the digit grouping separators removed from a Valoren on top of those removed by Normalize
+var valorenSeparators = strings.NewReplacer(".", "", "'", "")
+
+// validateValoren validates the Valoren without recording metrics
+func validateValoren(valoren string) (string, error) {
+	digits := valorenSeparators.Replace(Normalize(valoren))
+	if digits == "" {
+		err := emptyError(TypeValoren)
+		return "", err
+	}
+	for i, char := range digits {
+		if char < '0' || char > '9' {
+			err := invalidCharacter(TypeValoren, digits, i, "Valoren must only contain digits, found '%c'", char)
+			return "", err
+		}
+	}
+
+	valoren = strings.TrimLeft(digits, "0")
+	if valoren == "" {
+		err := fmt.Errorf("%w: Valoren cannot be zero", ErrInvalidFormat)
+		return "", err
+	}
+	if len(valoren) > 12 {
+		err := fmt.Errorf("%w: Valoren cannot be more than 12 digits long. Provided: %s", ErrInvalidLength, provided(valoren))
+		return "", err
+	}
+
+	return valoren, nil
+}
+
+// ValorenToISIN validates the Valoren and converts it to a CH ISIN by left-padding it with zeros to a 9-character NSIN, prefixing CH, and appending the ISIN check digit
+// Valoren longer than 9 digits don't fit in an ISIN and return an error.
+func ValorenToISIN(valoren string) (string, error) {
+	valoren, err := Valoren(valoren)
+	if err != nil {
+		return "", err
+	}
+	if len(valoren) > 9 {
+		err := fmt.Errorf("%w: Valoren must be at most 9 digits long to be embedded in an ISIN. Provided: %s", ErrInvalidLength, provided(valoren))
+		return "", err
+	}
+
+	return nsinToISIN(strings.Repeat("0", 9-len(valoren))+valoren, "CH", "CH")
+}
@@ -0,0 +1,35 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValoren(t *testing.T) {
+	tests := []struct {
+		valoren string
+		want    string
+		err     error
+	}{
+		{"1203204", "1203204", nil},
+		{"001203204", "1203204", nil},
+		{"1'203'204", "1203204", nil},
+		{"Valoren: 1.203.204", "1203204", nil},
+		{"CH0012032048", "", ErrInvalidCharacter}, //an ISIN isn't a Valoren, even though it embeds one
+		{"12O3204", "", ErrInvalidCharacter},
+		{"000", "", ErrInvalidFormat},
+		{"1234567890123", "", ErrInvalidLength},
+		{" ", "", ErrEmpty},
+	}
+	for _, test := range tests {
+		got, err := Valoren(test.valoren)
+		if got != test.want || !errors.Is(err, test.err) {
+			t.Errorf("Valoren(%q) = %q, %v; want %q, %v", test.valoren, got, err, test.want, test.err)
+		}
+	}
+
+	isin, err := ValorenToISIN("1203204")
+	if isin != "CH0012032048" || err != nil {
+		t.Errorf("ValorenToISIN(%q) = %q, %v; want %q", "1203204", isin, err, "CH0012032048")
+	}
+}
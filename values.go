@@ -0,0 +1,10 @@
+package identifiers
+
+// ISINValue is an ISIN that is validated when it is scanned from a database or decoded from JSON or text
+type ISINValue string
+
+// CUSIPValue is a CUSIP that is validated when it is scanned from a database or decoded from JSON or text
+type CUSIPValue string
+
+// FIGIValue is a FIGI that is validated when it is scanned from a database or decoded from JSON or text
+type FIGIValue string
@@ -0,0 +1,44 @@
+package identifiers
+
+import "fmt"
+
+// WKN takes a string containing a WKN but possibly more than just the WKN, strips it, validates it is a well-formed WKN, and returns just the WKN
+// A WKN (Wertpapierkennnummer) is a 6-character German securities code of digits and letters other than I and O; it has no check digit so only the format is validated.
+func WKN(wkn string) (string, error) {
+	clean, err := validateWKN(wkn)
+	record(TypeWKN, err)
+	return clean, err
+}
+
+// validateWKN validates the WKN without recording metrics
+func validateWKN(wkn string) (string, error) {
+	wkn = Normalize(wkn)
+	if wkn == "" {
+		err := emptyError(TypeWKN)
+		return "", err
+	}
+	if len(wkn) < 6 {
+		err := fmt.Errorf("%w: WKN must be at least 6 characters long. Provided: %s", ErrTooShort, provided(wkn))
+		return "", err
+	}
+	wkn = wkn[0:6]
+
+	for i, char := range wkn {
+		if char == 'I' || char == 'O' { //not used to avoid confusion with 1 and 0
+			err := invalidCharacter(TypeWKN, wkn, i, "WKN cannot contain the letter '%c'", char)
+			return "", err
+		}
+		if (char < '0' || char > '9') && (char < 'A' || char > 'Z') {
+			err := invalidCharacter(TypeWKN, wkn, i, "WKN must only contain letters and digits, found '%c'", char)
+			return "", err
+		}
+	}
+
+	return wkn, nil
+}
+
+// ValidWKN reports whether the string starts with a well-formed WKN
+func ValidWKN(wkn string) bool {
+	_, err := WKN(wkn)
+	return err == nil
+}